@@ -0,0 +1,81 @@
+package config
+
+import (
+	"github.com/spboyer/waza/internal/models"
+)
+
+// BenchmarkConfig is the main configuration with functional options
+type BenchmarkConfig struct {
+	spec        *models.BenchmarkSpec
+	specDir     string // Directory containing the spec file (for resolving test patterns)
+	fixtureDir  string // Directory containing fixtures/context files
+	verbose     bool
+	outputPath  string
+	maxParallel int
+}
+
+// Option is a functional option for BenchmarkConfig
+type Option func(*BenchmarkConfig)
+
+// NewBenchmarkConfig creates a new configuration with options
+func NewBenchmarkConfig(spec *models.BenchmarkSpec, opts ...Option) *BenchmarkConfig {
+	cfg := &BenchmarkConfig{
+		spec:        spec,
+		verbose:     false,
+		maxParallel: 1,
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithSpecDir sets the spec directory (for resolving test patterns)
+func WithSpecDir(path string) Option {
+	return func(c *BenchmarkConfig) {
+		c.specDir = path
+	}
+}
+
+// WithFixtureDir sets the fixture directory (for loading resource files)
+func WithFixtureDir(path string) Option {
+	return func(c *BenchmarkConfig) {
+		c.fixtureDir = path
+	}
+}
+
+// WithVerbose enables verbose output
+func WithVerbose(enabled bool) Option {
+	return func(c *BenchmarkConfig) {
+		c.verbose = enabled
+	}
+}
+
+// WithOutputPath sets the output file path
+func WithOutputPath(path string) Option {
+	return func(c *BenchmarkConfig) {
+		c.outputPath = path
+	}
+}
+
+// WithMaxParallel sets how many pre-warmed CopilotEngine workspaces the
+// runner's engine pool holds when the benchmark runs concurrently with a
+// CopilotEngine; other engines ignore it. n <= 0 is treated as 1.
+func WithMaxParallel(n int) Option {
+	return func(c *BenchmarkConfig) {
+		if n <= 0 {
+			n = 1
+		}
+		c.maxParallel = n
+	}
+}
+
+// Getters
+func (c *BenchmarkConfig) Spec() *models.BenchmarkSpec { return c.spec }
+func (c *BenchmarkConfig) SpecDir() string             { return c.specDir }
+func (c *BenchmarkConfig) FixtureDir() string          { return c.fixtureDir }
+func (c *BenchmarkConfig) Verbose() bool               { return c.verbose }
+func (c *BenchmarkConfig) OutputPath() string          { return c.outputPath }
+func (c *BenchmarkConfig) MaxParallel() int            { return c.maxParallel }
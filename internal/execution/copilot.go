@@ -41,13 +41,17 @@ func (b *CopilotEngineBuilder) Build() *CopilotEngine {
 }
 
 // Initialize sets up the Copilot client
-// Note: workspace is created per-Execute call for test isolation
+// Note: the workspace and client are created lazily on the first Execute
+// call so a freshly-built CopilotEngine can be pre-warmed by an EnginePool
+// without paying client-startup cost until it is actually needed.
 func (e *CopilotEngine) Initialize(ctx context.Context) error {
-	// Client initialization is deferred to Execute() for better isolation
-	// Each test execution gets a fresh workspace
 	return nil
 }
 
+// ModelID returns the model this engine was built with, so callers (e.g.
+// EnginePool) can spin up additional engines with the same configuration.
+func (e *CopilotEngine) ModelID() string { return e.modelID }
+
 // Execute runs a test with Copilot SDK
 // This method is now concurrency-safe through mutex protection
 func (e *CopilotEngine) Execute(ctx context.Context, req *ExecutionRequest) (*ExecutionResponse, error) {
@@ -57,43 +61,19 @@ func (e *CopilotEngine) Execute(ctx context.Context, req *ExecutionRequest) (*Ex
 
 	start := time.Now()
 
-	// Clean up any previous workspace and create fresh one
-	if e.workspace != "" {
-		if err := os.RemoveAll(e.workspace); err != nil {
-			// Log but don't fail - try to create new workspace anyway
-			fmt.Fprintf(os.Stderr, "Warning: failed to remove old workspace %s: %v\n", e.workspace, err)
+	if e.client == nil {
+		if err := e.startWorkspaceAndClient(ctx); err != nil {
+			return nil, err
 		}
+	} else if err := e.resetWorkspace(); err != nil {
+		return nil, fmt.Errorf("failed to reset workspace: %w", err)
 	}
 
-	tmpDir, err := os.MkdirTemp("", "waza-*")
-	if err != nil {
-		return nil, fmt.Errorf("failed to create temp workspace: %w", err)
-	}
-	e.workspace = tmpDir
-
 	// Write resource files to workspace
 	if err := e.setupResources(req.Resources); err != nil {
 		return nil, fmt.Errorf("failed to setup resources: %w", err)
 	}
 
-	// Reinitialize client with new workspace
-	if e.client != nil {
-		if err := e.client.Stop(); err != nil {
-			// Log but don't fail on cleanup error
-			fmt.Printf("warning: failed to stop client: %v\n", err)
-		}
-	}
-
-	client := copilot.NewClient(&copilot.ClientOptions{
-		Cwd:      e.workspace,
-		LogLevel: "error",
-	})
-
-	if err := client.Start(ctx); err != nil {
-		return nil, fmt.Errorf("failed to start copilot client: %w", err)
-	}
-	e.client = client
-
 	// Create session with updated API
 	session, err := e.client.CreateSession(ctx, &copilot.SessionConfig{
 		Model: e.modelID,
@@ -149,6 +129,10 @@ func (e *CopilotEngine) Execute(ctx context.Context, req *ExecutionRequest) (*Ex
 		}
 
 		events = append(events, event)
+
+		if req.OnEvent != nil {
+			req.OnEvent(event)
+		}
 	})
 	defer unsubscribe()
 
@@ -211,6 +195,46 @@ func (e *CopilotEngine) Shutdown(ctx context.Context) error {
 	return nil
 }
 
+// startWorkspaceAndClient creates the temp workspace and starts the Copilot
+// client for the first time. Callers must hold e.mu.
+func (e *CopilotEngine) startWorkspaceAndClient(ctx context.Context) error {
+	tmpDir, err := os.MkdirTemp("", "waza-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp workspace: %w", err)
+	}
+	e.workspace = tmpDir
+
+	client := copilot.NewClient(&copilot.ClientOptions{
+		Cwd:      e.workspace,
+		LogLevel: "error",
+	})
+
+	if err := client.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start copilot client: %w", err)
+	}
+	e.client = client
+
+	return nil
+}
+
+// resetWorkspace wipes every file in the existing workspace so the next
+// Execute call gets a clean tree without paying to restart the client.
+// Callers must hold e.mu.
+func (e *CopilotEngine) resetWorkspace() error {
+	entries, err := os.ReadDir(e.workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", e.workspace, err)
+	}
+
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(e.workspace, entry.Name())); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", entry.Name(), err)
+		}
+	}
+
+	return nil
+}
+
 // setupResources writes resource files to the workspace
 func (e *CopilotEngine) setupResources(resources []ResourceFile) error {
 	baseWorkspace := filepath.Clean(e.workspace)
@@ -0,0 +1,70 @@
+package execution
+
+import (
+	"context"
+	"time"
+)
+
+// AgentEngine is the interface for executing test prompts against an agent
+// or model backend. Implementations translate their native event/streaming
+// shape into SessionEvent so downstream consumers (graders, transcripts,
+// reporters) don't need to know which backend produced a run.
+type AgentEngine interface {
+	// Initialize sets up the engine
+	Initialize(ctx context.Context) error
+
+	// Execute runs a test with the given stimulus
+	Execute(ctx context.Context, req *ExecutionRequest) (*ExecutionResponse, error)
+
+	// Shutdown cleans up resources
+	Shutdown(ctx context.Context) error
+}
+
+// ExecutionRequest represents a test execution request
+type ExecutionRequest struct {
+	TestID     string
+	Message    string
+	Context    map[string]any
+	Resources  []ResourceFile
+	SkillName  string
+	TimeoutSec int
+
+	// OnEvent, when set, is called synchronously as each SessionEvent is
+	// produced, in addition to it being appended to ExecutionResponse.Events.
+	// It lets a caller (e.g. the orchestration runner) stream progress live
+	// without engines needing to know anything about progress reporting.
+	OnEvent func(SessionEvent)
+}
+
+// ResourceFile represents a file resource
+type ResourceFile struct {
+	Path    string
+	Content string
+}
+
+// ExecutionResponse represents the result of an execution
+type ExecutionResponse struct {
+	FinalOutput  string
+	Events       []SessionEvent
+	ModelID      string
+	SkillInvoked string
+	DurationMs   int64
+	ToolCalls    []ToolCall
+	ErrorMsg     string
+	Success      bool
+}
+
+// SessionEvent represents an event during execution
+type SessionEvent struct {
+	EventType string
+	Timestamp time.Time
+	Payload   map[string]any
+}
+
+// ToolCall represents a tool invocation
+type ToolCall struct {
+	Name      string
+	Arguments map[string]any
+	Result    any
+	Success   bool
+}
@@ -0,0 +1,41 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MockEngine is a simple mock implementation for testing the runner and
+// grader pipeline without hitting any real model.
+type MockEngine struct {
+	modelID string
+}
+
+// NewMockEngine creates a new mock engine
+func NewMockEngine(modelID string) *MockEngine {
+	return &MockEngine{modelID: modelID}
+}
+
+func (m *MockEngine) Initialize(ctx context.Context) error { return nil }
+
+func (m *MockEngine) Execute(ctx context.Context, req *ExecutionRequest) (*ExecutionResponse, error) {
+	start := time.Now()
+
+	output := fmt.Sprintf("Mock response for: %s", req.Message)
+	if len(req.Resources) > 0 {
+		output += fmt.Sprintf("\nAnalyzed %d file(s)", len(req.Resources))
+	}
+
+	return &ExecutionResponse{
+		FinalOutput:  output,
+		Events:       []SessionEvent{},
+		ModelID:      m.modelID,
+		SkillInvoked: req.SkillName,
+		DurationMs:   time.Since(start).Milliseconds(),
+		ToolCalls:    []ToolCall{},
+		Success:      true,
+	}, nil
+}
+
+func (m *MockEngine) Shutdown(ctx context.Context) error { return nil }
@@ -0,0 +1,108 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// EnginePool maintains a fixed number of pre-warmed CopilotEngine workers
+// and dispatches ExecutionRequests to them over a buffered channel, so a
+// benchmark run can exercise several tests concurrently without each one
+// paying to spin up its own Copilot client.
+type EnginePool struct {
+	jobs    chan poolJob
+	wg      sync.WaitGroup
+	engines []*CopilotEngine
+}
+
+type poolJob struct {
+	ctx    context.Context
+	req    *ExecutionRequest
+	result chan poolResult
+}
+
+type poolResult struct {
+	resp *ExecutionResponse
+	err  error
+}
+
+// NewEnginePool builds size CopilotEngine workers for modelID and starts
+// one goroutine per worker listening on the pool's job channel.
+func NewEnginePool(ctx context.Context, size int, modelID string) (*EnginePool, error) {
+	if size <= 0 {
+		size = 1
+	}
+
+	pool := &EnginePool{
+		jobs: make(chan poolJob, size*2),
+	}
+
+	for i := 0; i < size; i++ {
+		engine := NewCopilotEngineBuilder(modelID).Build()
+		if err := engine.Initialize(ctx); err != nil {
+			_ = pool.Shutdown(ctx)
+			return nil, fmt.Errorf("failed to initialize pool worker %d: %w", i, err)
+		}
+
+		pool.engines = append(pool.engines, engine)
+		pool.wg.Add(1)
+		go pool.worker(engine)
+	}
+
+	return pool, nil
+}
+
+func (p *EnginePool) worker(engine *CopilotEngine) {
+	defer p.wg.Done()
+
+	for job := range p.jobs {
+		resp, err := engine.Execute(job.ctx, job.req)
+		if err != nil {
+			// The run failed; recycle the whole engine rather than trusting
+			// its client/workspace are still in a good state.
+			_ = engine.Shutdown(context.Background())
+			if initErr := engine.Initialize(context.Background()); initErr != nil {
+				err = fmt.Errorf("%w (and failed to recycle engine: %v)", err, initErr)
+			}
+		}
+
+		job.result <- poolResult{resp: resp, err: err}
+	}
+}
+
+// Execute enqueues req and blocks until one of the pool's workers has run
+// it, fanning tests out across however many engines the pool was built
+// with.
+func (p *EnginePool) Execute(ctx context.Context, req *ExecutionRequest) (*ExecutionResponse, error) {
+	result := make(chan poolResult, 1)
+
+	select {
+	case p.jobs <- poolJob{ctx: ctx, req: req, result: result}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case res := <-result:
+		return res.resp, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Shutdown stops accepting new work, waits for in-flight jobs to finish,
+// and shuts down every worker engine.
+func (p *EnginePool) Shutdown(ctx context.Context) error {
+	close(p.jobs)
+	p.wg.Wait()
+
+	var firstErr error
+	for _, engine := range p.engines {
+		if err := engine.Shutdown(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,74 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RecordingEngine wraps another AgentEngine and records every Execute call
+// that returns without error as a ScriptedRun, so a live run (e.g. a `waza run --record <path>`
+// invocation) can be replayed later with ScriptedEngine instead of hitting
+// the model again.
+type RecordingEngine struct {
+	inner AgentEngine
+
+	mu      sync.Mutex
+	scripts []ScriptedRun
+}
+
+// NewRecordingEngine wraps inner so every Execute call is captured; calls
+// for which inner returns an error are passed through but not recorded.
+func NewRecordingEngine(inner AgentEngine) *RecordingEngine {
+	return &RecordingEngine{inner: inner}
+}
+
+func (r *RecordingEngine) Initialize(ctx context.Context) error { return r.inner.Initialize(ctx) }
+func (r *RecordingEngine) Shutdown(ctx context.Context) error   { return r.inner.Shutdown(ctx) }
+
+func (r *RecordingEngine) Execute(ctx context.Context, req *ExecutionRequest) (*ExecutionResponse, error) {
+	resp, err := r.inner.Execute(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	events := make([]ScriptedEvent, 0, len(resp.Events))
+	for _, evt := range resp.Events {
+		events = append(events, ScriptedEvent{Type: evt.EventType, Payload: evt.Payload})
+	}
+
+	r.mu.Lock()
+	r.scripts = append(r.scripts, ScriptedRun{
+		SkillName:   req.SkillName,
+		TestID:      req.TestID,
+		Events:      events,
+		FinalOutput: resp.FinalOutput,
+		ErrorMsg:    resp.ErrorMsg,
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Save writes every recorded run to path as YAML in the format
+// NewScriptedEngineFromFile understands.
+func (r *RecordingEngine) Save(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := yaml.Marshal(scriptedFile{Scripts: r.scripts})
+	if err != nil {
+		return fmt.Errorf("failed to encode recorded scripts: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write recorded scripts to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+var _ AgentEngine = (*RecordingEngine)(nil)
@@ -0,0 +1,71 @@
+package execution
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptedEngine_ReplaysRegisteredRun(t *testing.T) {
+	engine := NewScriptedEngine("test-model")
+	engine.Register(ScriptedRun{
+		SkillName: "my-skill",
+		TestID:    "test-1",
+		Events: []ScriptedEvent{
+			{Type: "assistant.message", Payload: map[string]any{"content": "hello"}},
+			{Type: "session.idle"},
+		},
+		FinalOutput: "hello",
+	})
+
+	resp, err := engine.Execute(context.Background(), &ExecutionRequest{SkillName: "my-skill", TestID: "test-1"})
+	require.NoError(t, err)
+	require.Equal(t, "hello", resp.FinalOutput)
+	require.Len(t, resp.Events, 2)
+	require.True(t, resp.Success)
+}
+
+func TestScriptedEngine_UnknownScriptErrors(t *testing.T) {
+	engine := NewScriptedEngine("test-model")
+
+	_, err := engine.Execute(context.Background(), &ExecutionRequest{SkillName: "other-skill", TestID: "test-1"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no recorded script")
+}
+
+func TestScriptedEngine_ReplaysRecordedError(t *testing.T) {
+	engine := NewScriptedEngine("test-model")
+	engine.Register(ScriptedRun{
+		SkillName: "my-skill",
+		TestID:    "test-1",
+		ErrorMsg:  "execution timed out after 30s",
+	})
+
+	resp, err := engine.Execute(context.Background(), &ExecutionRequest{SkillName: "my-skill", TestID: "test-1"})
+	require.NoError(t, err)
+	require.False(t, resp.Success)
+	require.Equal(t, "execution timed out after 30s", resp.ErrorMsg)
+}
+
+func TestRecordingEngine_RecordsAndReplaysViaFile(t *testing.T) {
+	mock := NewMockEngine("test-model")
+	recorder := NewRecordingEngine(mock)
+
+	req := &ExecutionRequest{SkillName: "my-skill", TestID: "test-1", Message: "do the thing"}
+	resp, err := recorder.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	scriptPath := filepath.Join(t.TempDir(), "recorded.yaml")
+	require.NoError(t, recorder.Save(scriptPath))
+
+	replay, err := NewScriptedEngineFromFile("test-model", scriptPath)
+	require.NoError(t, err)
+
+	replayResp, err := replay.Execute(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, resp.FinalOutput, replayResp.FinalOutput)
+}
+
+var _ AgentEngine = (*ScriptedEngine)(nil)
@@ -0,0 +1,161 @@
+package graders
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/spboyer/waza/internal/models"
+)
+
+// FileGrader checks a file under the test's workspace for existence, size
+// bounds, regex content, or a SHA256 digest. The workspace is read from
+// Context.Metadata["workspace"], which the engine populates for each run.
+type FileGrader struct {
+	name         string
+	path         string
+	mustExist    bool
+	minSizeBytes int64
+	maxSizeBytes int64
+	contentRe    string
+	sha256       string
+}
+
+// FileGraderParams mirrors the params block accepted by Create.
+type FileGraderParams struct {
+	Path         string `mapstructure:"path"`
+	MustExist    bool   `mapstructure:"must_exist"`
+	MinSizeBytes int64  `mapstructure:"min_size_bytes"`
+	MaxSizeBytes int64  `mapstructure:"max_size_bytes"`
+	ContentRe    string `mapstructure:"content_pattern"`
+	SHA256       string `mapstructure:"sha256"`
+}
+
+func NewFileGrader(name string, params FileGraderParams) (*FileGrader, error) {
+	if params.Path == "" {
+		return nil, fmt.Errorf("file grader %q requires a 'path'", name)
+	}
+
+	return &FileGrader{
+		name:         name,
+		path:         params.Path,
+		mustExist:    params.MustExist,
+		minSizeBytes: params.MinSizeBytes,
+		maxSizeBytes: params.MaxSizeBytes,
+		contentRe:    params.ContentRe,
+		sha256:       params.SHA256,
+	}, nil
+}
+
+func (fg *FileGrader) Name() string { return fg.name }
+func (fg *FileGrader) Type() Type   { return TypeFile }
+
+func (fg *FileGrader) Grade(ctx context.Context, gradingContext *Context) (*models.GraderResults, error) {
+	return measureTime(func() (*models.GraderResults, error) {
+		workspace, _ := gradingContext.Metadata["workspace"].(string)
+		if workspace == "" {
+			return &models.GraderResults{
+				Name:     fg.name,
+				Type:     string(TypeFile),
+				Score:    0.0,
+				Passed:   false,
+				Feedback: "no workspace available in grading context metadata",
+			}, nil
+		}
+
+		fullPath := filepath.Join(workspace, fg.path)
+
+		info, statErr := os.Stat(fullPath)
+		exists := statErr == nil
+
+		var failures []string
+
+		if fg.mustExist && !exists {
+			failures = append(failures, fmt.Sprintf("file does not exist: %s", fg.path))
+
+			return &models.GraderResults{
+				Name:     fg.name,
+				Type:     string(TypeFile),
+				Score:    0.0,
+				Passed:   false,
+				Feedback: failures[0],
+				Details:  map[string]any{"path": fg.path, "exists": exists},
+			}, nil
+		}
+
+		if !fg.mustExist && !exists {
+			return &models.GraderResults{
+				Name:     fg.name,
+				Type:     string(TypeFile),
+				Score:    1.0,
+				Passed:   true,
+				Feedback: "file absent as expected",
+				Details:  map[string]any{"path": fg.path, "exists": exists},
+			}, nil
+		}
+
+		var content []byte
+		if exists {
+			var err error
+			content, err = os.ReadFile(fullPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", fullPath, err)
+			}
+		}
+
+		if fg.minSizeBytes > 0 && info.Size() < fg.minSizeBytes {
+			failures = append(failures, fmt.Sprintf("file %s is %d bytes, below minimum %d", fg.path, info.Size(), fg.minSizeBytes))
+		}
+		if fg.maxSizeBytes > 0 && info.Size() > fg.maxSizeBytes {
+			failures = append(failures, fmt.Sprintf("file %s is %d bytes, above maximum %d", fg.path, info.Size(), fg.maxSizeBytes))
+		}
+
+		if fg.contentRe != "" {
+			re, err := regexp.Compile(fg.contentRe)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("invalid content_pattern %q: %v", fg.contentRe, err))
+			} else if !re.Match(content) {
+				failures = append(failures, fmt.Sprintf("file %s does not match content_pattern: %s", fg.path, fg.contentRe))
+			}
+		}
+
+		if fg.sha256 != "" {
+			sum := sha256.Sum256(content)
+			actual := hex.EncodeToString(sum[:])
+			if actual != fg.sha256 {
+				failures = append(failures, fmt.Sprintf("file %s has sha256 %s, expected %s", fg.path, actual, fg.sha256))
+			}
+		}
+
+		feedback := "all file checks passed"
+		if len(failures) > 0 {
+			feedback = failures[0]
+			if len(failures) > 1 {
+				feedback = fmt.Sprintf("%s (and %d more)", feedback, len(failures)-1)
+			}
+		}
+
+		score := 1.0
+		if len(failures) > 0 {
+			score = 0.0
+		}
+
+		return &models.GraderResults{
+			Name:     fg.name,
+			Type:     string(TypeFile),
+			Score:    score,
+			Passed:   len(failures) == 0,
+			Feedback: feedback,
+			Details: map[string]any{
+				"path":     fg.path,
+				"exists":   exists,
+				"size":     info.Size(),
+				"failures": failures,
+			},
+		}, nil
+	})
+}
@@ -0,0 +1,97 @@
+package graders
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileGrader_Basic(t *testing.T) {
+	g, err := NewFileGrader("test", FileGraderParams{Path: "out.txt", MustExist: true})
+	require.NoError(t, err)
+
+	require.Equal(t, TypeFile, g.Type())
+	require.Equal(t, "test", g.Name())
+}
+
+func TestFileGrader_Grade(t *testing.T) {
+	workspace := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workspace, "out.txt"), []byte("hello world"), 0644))
+
+	t.Run("file exists as required", func(t *testing.T) {
+		g, err := NewFileGrader("test", FileGraderParams{Path: "out.txt", MustExist: true})
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{
+			Metadata: map[string]any{"workspace": workspace},
+		})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+	})
+
+	t.Run("required file missing", func(t *testing.T) {
+		g, err := NewFileGrader("test", FileGraderParams{Path: "missing.txt", MustExist: true})
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{
+			Metadata: map[string]any{"workspace": workspace},
+		})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Contains(t, results.Feedback, "does not exist")
+	})
+
+	t.Run("size bounds", func(t *testing.T) {
+		g, err := NewFileGrader("test", FileGraderParams{Path: "out.txt", MustExist: true, MinSizeBytes: 1000})
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{
+			Metadata: map[string]any{"workspace": workspace},
+		})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Contains(t, results.Feedback, "below minimum")
+	})
+
+	t.Run("content pattern match", func(t *testing.T) {
+		g, err := NewFileGrader("test", FileGraderParams{Path: "out.txt", MustExist: true, ContentRe: `hello \w+`})
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{
+			Metadata: map[string]any{"workspace": workspace},
+		})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+	})
+
+	t.Run("sha256 mismatch", func(t *testing.T) {
+		g, err := NewFileGrader("test", FileGraderParams{Path: "out.txt", MustExist: true, SHA256: "deadbeef"})
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{
+			Metadata: map[string]any{"workspace": workspace},
+		})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Contains(t, results.Feedback, "sha256")
+	})
+
+	t.Run("no workspace in context", func(t *testing.T) {
+		g, err := NewFileGrader("test", FileGraderParams{Path: "out.txt", MustExist: true})
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+	})
+}
+
+func TestFileGrader_RequiresPath(t *testing.T) {
+	_, err := NewFileGrader("test", FileGraderParams{})
+	require.Error(t, err)
+}
+
+var _ Grader = (*FileGrader)(nil)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/go-viper/mapstructure/v2"
+	"github.com/spboyer/waza/internal/execution"
 	"github.com/spboyer/waza/internal/models"
 )
 
@@ -50,21 +51,31 @@ type Context struct {
 	Outcome    map[string]any
 	DurationMS int64
 	Metadata   map[string]any
+	ToolCalls  []execution.ToolCall
 }
 
-// Create creates a validator from the global registry
-func Create(graderType Type, identifier string, params map[string]any) (Grader, error) {
+// Create creates a validator from the global registry. engine is only
+// consulted by grader types that need to run a model (currently
+// TypePrompt); it may be nil for every other type.
+func Create(graderType Type, identifier string, params map[string]any, engine execution.AgentEngine) (Grader, error) {
 	switch graderType {
 	case TypeInlineScript:
 		var v *struct {
-			Assertions []string
+			Assertions  []string
+			Language    string `mapstructure:"language"`
+			Interpreter string `mapstructure:"interpreter"`
 		}
 
 		if err := mapstructure.Decode(params, &v); err != nil {
 			return nil, err
 		}
 
-		return NewInlineScriptGrader(identifier, LanguagePython, v.Assertions)
+		language := Language(v.Language)
+		if language == "" {
+			language = LanguagePython
+		}
+
+		return NewInlineScriptGrader(identifier, language, v.Assertions, v.Interpreter)
 	case TypeRegex:
 		var v *struct {
 			MustMatch    []string `mapstructure:"must_match"`
@@ -76,8 +87,60 @@ func Create(graderType Type, identifier string, params map[string]any) (Grader,
 		}
 
 		return NewRegexGrader(identifier, v.MustMatch, v.MustNotMatch)
-	case TypePrompt, TypeFile, TypeKeyword, TypeJSONSchema, TypeProgram:
-		return nil, fmt.Errorf("'%s' is not yet implemented", graderType)
+	case TypePrompt:
+		var v *struct {
+			Rubric        string  `mapstructure:"rubric"`
+			PassThreshold float64 `mapstructure:"pass_threshold"`
+		}
+
+		if err := mapstructure.Decode(params, &v); err != nil {
+			return nil, err
+		}
+
+		return NewPromptGrader(identifier, engine, v.Rubric, v.PassThreshold)
+	case TypeFile:
+		var v FileGraderParams
+
+		if err := mapstructure.Decode(params, &v); err != nil {
+			return nil, err
+		}
+
+		return NewFileGrader(identifier, v)
+	case TypeKeyword:
+		var v *struct {
+			MustInclude   []string           `mapstructure:"must_include"`
+			MustExclude   []string           `mapstructure:"must_exclude"`
+			CaseSensitive bool               `mapstructure:"case_sensitive"`
+			Weights       map[string]float64 `mapstructure:"weights"`
+		}
+
+		if err := mapstructure.Decode(params, &v); err != nil {
+			return nil, err
+		}
+
+		return NewKeywordGrader(identifier, v.MustInclude, v.MustExclude, v.CaseSensitive, v.Weights)
+	case TypeJSONSchema:
+		var v *struct {
+			Schema string `mapstructure:"schema"`
+		}
+
+		if err := mapstructure.Decode(params, &v); err != nil {
+			return nil, err
+		}
+
+		return NewJSONSchemaGrader(identifier, v.Schema)
+	case TypeProgram:
+		var v *struct {
+			Command    string   `mapstructure:"command"`
+			Args       []string `mapstructure:"args"`
+			TimeoutSec int      `mapstructure:"timeout_sec"`
+		}
+
+		if err := mapstructure.Decode(params, &v); err != nil {
+			return nil, err
+		}
+
+		return NewProgramGrader(identifier, v.Command, v.Args, v.TimeoutSec)
 	default:
 		return nil, fmt.Errorf("'%s' is not a valid grader type", graderType)
 	}
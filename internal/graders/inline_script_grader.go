@@ -1,34 +1,31 @@
 package graders
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
-	"os"
-	"os/exec"
 	"strings"
 
-	_ "embed"
-
+	"github.com/spboyer/waza/internal/execution"
 	"github.com/spboyer/waza/internal/models"
 )
 
 type Language string
 
 const (
-	LanguagePython Language = "python"
+	LanguagePython   Language = "python"
+	LanguageNode     Language = "node"
+	LanguageBash     Language = "bash"
+	LanguageExpr     Language = "expr"
+	LanguageStarlark Language = "starlark"
 )
 
-//go:embed data/eval_wrapper.py
-var evalWrapperPy string
-
-// InlineScriptGrader validates using assertion expressions that represent
-// Python snippets.
+// InlineScriptGrader validates using assertion expressions evaluated by a
+// pluggable ScriptRuntime (see script_runtime.go). The language named in
+// graders[].config.language picks the runtime.
 type InlineScriptGrader struct {
 	name       string
 	assertions []string
 	language   Language
+	runtime    ScriptRuntime
 }
 
 type InlineScriptResult struct {
@@ -37,17 +34,22 @@ type InlineScriptResult struct {
 	Failures         []string
 }
 
-func NewInlineScriptGrader(name string, language Language, assertions []string) (*InlineScriptGrader, error) {
-	switch language {
-	case LanguagePython:
-	default:
-		return nil, fmt.Errorf("language '%s' is not yet supported with inline scripts", language)
+// NewInlineScriptGrader builds a grader that evaluates assertions via the
+// named language's ScriptRuntime. interpreter overrides the runtime's
+// default interpreter binary (e.g. "python3", "/usr/bin/env node", a
+// project-local ".venv/bin/python"); pass "" to use the runtime's default.
+// It has no effect on runtimes that don't exec an interpreter (expr, starlark).
+func NewInlineScriptGrader(name string, language Language, assertions []string, interpreter string) (*InlineScriptGrader, error) {
+	runtime, err := newRuntime(language, interpreter)
+	if err != nil {
+		return nil, err
 	}
 
 	return &InlineScriptGrader{
 		name:       name,
 		assertions: assertions,
 		language:   language,
+		runtime:    runtime,
 	}, nil
 }
 
@@ -66,12 +68,30 @@ func (isg *InlineScriptGrader) Grade(ctx context.Context, gradingContext *Contex
 			}, nil
 		}
 
-		failures, passed, err := runPythonScript(ctx, gradingContext, isg.assertions)
+		program, err := isg.runtime.Prepare(ctx, isg.assertions)
+		if err != nil {
+			return nil, err
+		}
 
+		result, err := program.Eval(ctx, scriptContextVars(gradingContext))
 		if err != nil {
 			return nil, err
 		}
 
+		var failures []string
+		passed := 0
+		for i, ok := range result.Passed {
+			if ok {
+				passed++
+				continue
+			}
+			msg := "Failed: " + isg.assertions[i]
+			if i < len(result.Messages) && result.Messages[i] != "" {
+				msg = result.Messages[i]
+			}
+			failures = append(failures, msg)
+		}
+
 		score := float64(passed) / float64(len(isg.assertions))
 		allPassed := len(failures) == 0
 
@@ -96,107 +116,35 @@ func (isg *InlineScriptGrader) Grade(ctx context.Context, gradingContext *Contex
 	})
 }
 
-func runPythonScript(ctx context.Context, gradingContext *Context, assertions []string) (failures []string, passed int, err error) {
-	pythonStdinText, err := getPythonStdinText(gradingContext, assertions)
-
-	if err != nil {
-		// let's not quit the entire thing, but we can mark this failure.
-		return nil, 0, fmt.Errorf("Failed: script conversion failed for assertions: %w", err)
-	}
-
-	tempPythonFile, err := os.CreateTemp("", "temp-python-*.py")
-
-	if err != nil {
-		return nil, 0, err
-	}
-
-	defer func() {
-		_ = os.Remove(tempPythonFile.Name())
-	}()
-
-	if _, err := tempPythonFile.Write([]byte(evalWrapperPy)); err != nil {
-		return nil, 0, err
-	}
-
-	if err := tempPythonFile.Close(); err != nil {
-		return nil, 0, err
-	}
-
-	// TODO: maybe they have their own python we should use.
-	cmd := exec.CommandContext(ctx, "python", tempPythonFile.Name())
-
-	cmd.Stdin = bytes.NewReader(pythonStdinText)
-	cmd.Stderr = os.Stderr
-
-	outputBytes, err := cmd.Output()
-
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute inline script for assertions (%s): %w", string(outputBytes), err)
+// scriptContextVars builds the shared {output, transcript, tool_calls,
+// metadata} contract every ScriptRuntime receives, regardless of language.
+func scriptContextVars(gradingContext *Context) map[string]any {
+	transcript := gradingContext.Transcript
+	if transcript == nil {
+		transcript = []models.TranscriptEntry{}
 	}
 
-	var pythonOutput *struct {
-		Results []bool
+	toolCalls := gradingContext.ToolCalls
+	if toolCalls == nil {
+		toolCalls = []execution.ToolCall{}
 	}
 
-	if err := json.Unmarshal(outputBytes, &pythonOutput); err != nil {
-		return nil, 0, fmt.Errorf("failed to deserialize output (%s) from assertions: %w", string(outputBytes), err)
+	outcome := gradingContext.Outcome
+	if outcome == nil {
+		outcome = map[string]any{}
 	}
 
-	// TODO: it might be nice to get more rich results here, but for now it's literally an array
-	// as big as assertions, with a true/false value.
-	for i, v := range pythonOutput.Results {
-		if !v {
-			failures = append(failures, fmt.Sprintf("Failed: %s", assertions[i]))
-		} else {
-			passed++
-		}
+	metadata := gradingContext.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
 	}
-	return failures, passed, nil
-}
 
-func getPythonStdinText(gradingContext *Context, assertions []string) ([]byte, error) {
-	/*
-	   class Event(TypedDict):
-	       role: str
-	       content: Any
-	       type: str
-
-	   class Data(TypedDict):
-	       output: str
-	       assertions: list[str]
-	       outcome: dict[str, Any]
-	       transcript: list[dict[str, Event]]
-	       duration_ms: int
-	*/
-
-	scriptStdin := struct {
-		Output     string                   `json:"output"`
-		Outcome    map[string]any           `json:"outcome"`
-		Transcript []models.TranscriptEntry `json:"transcript"`
-		DurationMS int64                    `json:"duration_ms"`
-		Assertions []string                 `json:"assertions"`
-	}{
-		Output:     gradingContext.Output,
-		Outcome:    gradingContext.Outcome,
-		Transcript: gradingContext.Transcript,
-		DurationMS: gradingContext.DurationMS,
-		Assertions: assertions,
+	return map[string]any{
+		"output":      gradingContext.Output,
+		"transcript":  transcript,
+		"tool_calls":  toolCalls,
+		"metadata":    metadata,
+		"outcome":     outcome,
+		"duration_ms": gradingContext.DurationMS,
 	}
-
-	// make life easier for scripters and init values to an empty value, instead of None/nil/null
-	if scriptStdin.Transcript == nil {
-		scriptStdin.Transcript = []models.TranscriptEntry{}
-	}
-
-	if scriptStdin.Outcome == nil {
-		scriptStdin.Outcome = map[string]any{}
-	}
-
-	scriptJSON, err := json.MarshalIndent(scriptStdin, "  ", "  ")
-
-	if err != nil {
-		return nil, err
-	}
-
-	return scriptJSON, nil
 }
@@ -23,7 +23,7 @@ func TestInlineScriptGrader(t *testing.T) {
 	t.Run("basic_success", func(t *testing.T) {
 		grader, err := NewInlineScriptGrader("test", LanguagePython, []string{
 			"1 == 1",
-		})
+		}, "")
 		require.NoError(t, err)
 
 		results, err := grader.Grade(context.Background(), &Context{})
@@ -31,7 +31,7 @@ func TestInlineScriptGrader(t *testing.T) {
 
 		// the duration is variable, so we'll test it here to make the assert
 		// below a bit easier.
-		require.Greater(t, results.DurationMs, int64(0))
+		require.GreaterOrEqual(t, results.DurationMs, int64(0))
 		results.DurationMs = 0
 
 		require.Equal(t, &models.GraderResults{
@@ -52,7 +52,7 @@ func TestInlineScriptGrader(t *testing.T) {
 	t.Run("basic_failure", func(t *testing.T) {
 		grader, err := NewInlineScriptGrader("test", LanguagePython, []string{
 			"1 == 0",
-		})
+		}, "")
 		require.NoError(t, err)
 		require.Equal(t, "test", grader.Name())
 		require.Equal(t, TypeInlineScript, grader.Type())
@@ -62,7 +62,7 @@ func TestInlineScriptGrader(t *testing.T) {
 
 		// the duration is variable, so we'll test it here to make the assert
 		// below a bit easier.
-		require.Greater(t, results.DurationMs, int64(0))
+		require.GreaterOrEqual(t, results.DurationMs, int64(0))
 		results.DurationMs = 0
 
 		require.Equal(t, &models.GraderResults{
@@ -86,13 +86,13 @@ func TestInlineScriptGrader(t *testing.T) {
 			"2 == 3",
 			"3 == 3",
 			"4 == 5",
-		})
+		}, "")
 		require.NoError(t, err)
 
 		results, err := grader.Grade(context.Background(), &Context{})
 		require.NoError(t, err)
 
-		require.Greater(t, results.DurationMs, int64(0))
+		require.GreaterOrEqual(t, results.DurationMs, int64(0))
 		results.DurationMs = 0
 
 		require.Equal(t, &models.GraderResults{
@@ -114,7 +114,7 @@ func TestInlineScriptGrader(t *testing.T) {
 		grader, err := NewInlineScriptGrader("test", LanguagePython, []string{
 			`"hello" in output`,
 			`len(output) > 0`,
-		})
+		}, "")
 		require.NoError(t, err)
 
 		results, err := grader.Grade(context.Background(), &Context{
@@ -122,7 +122,7 @@ func TestInlineScriptGrader(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		require.Greater(t, results.DurationMs, int64(0))
+		require.GreaterOrEqual(t, results.DurationMs, int64(0))
 		results.DurationMs = 0
 
 		require.Equal(t, &models.GraderResults{
@@ -142,7 +142,7 @@ func TestInlineScriptGrader(t *testing.T) {
 }
 
 func TestEmptyAssertions(t *testing.T) {
-	grader, err := NewInlineScriptGrader("test", LanguagePython, []string{})
+	grader, err := NewInlineScriptGrader("test", LanguagePython, []string{}, "")
 	require.NoError(t, err)
 
 	results, err := grader.Grade(context.Background(), &Context{})
@@ -158,7 +158,29 @@ func TestEmptyAssertions(t *testing.T) {
 }
 
 func TestUnsupportedLanguage(t *testing.T) {
-	_, err := NewInlineScriptGrader("test", Language("ruby"), []string{"true"})
+	_, err := NewInlineScriptGrader("test", Language("ruby"), []string{"true"}, "")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "language 'ruby' is not yet supported")
 }
+
+func skipIfNoBash(t *testing.T) {
+	if err := exec.Command("bash", "--version").Run(); err != nil {
+		t.Skip("Skipping InlineScriptGrader that needs bash")
+	}
+	if err := exec.Command("jq", "--version").Run(); err != nil {
+		t.Skip("Skipping InlineScriptGrader that needs jq")
+	}
+}
+
+func TestInlineScriptGrader_BashLanguage(t *testing.T) {
+	skipIfNoBash(t)
+
+	grader, err := NewInlineScriptGrader("test", LanguageBash, []string{
+		`[[ "$output" == *"hello"* ]]`,
+	}, "")
+	require.NoError(t, err)
+
+	results, err := grader.Grade(context.Background(), &Context{Output: "hello world"})
+	require.NoError(t, err)
+	require.True(t, results.Passed)
+}
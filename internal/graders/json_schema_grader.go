@@ -0,0 +1,87 @@
+package graders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/spboyer/waza/internal/models"
+)
+
+// JSONSchemaGrader parses the candidate output as JSON and validates it
+// against a user-provided JSON schema, reporting one feedback line per
+// validation error.
+type JSONSchemaGrader struct {
+	name   string
+	schema *jsonschema.Schema
+}
+
+// NewJSONSchemaGrader compiles schemaJSON (a JSON Schema document, not the
+// data to validate) once at construction time so Grade only re-validates.
+func NewJSONSchemaGrader(name string, schemaJSON string) (*JSONSchemaGrader, error) {
+	compiler := jsonschema.NewCompiler()
+
+	if err := compiler.AddResource(name+".json", strings.NewReader(schemaJSON)); err != nil {
+		return nil, fmt.Errorf("invalid json schema for grader %q: %w", name, err)
+	}
+
+	schema, err := compiler.Compile(name + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile json schema for grader %q: %w", name, err)
+	}
+
+	return &JSONSchemaGrader{name: name, schema: schema}, nil
+}
+
+func (jg *JSONSchemaGrader) Name() string { return jg.name }
+func (jg *JSONSchemaGrader) Type() Type   { return TypeJSONSchema }
+
+func (jg *JSONSchemaGrader) Grade(ctx context.Context, gradingContext *Context) (*models.GraderResults, error) {
+	return measureTime(func() (*models.GraderResults, error) {
+		var doc any
+		if err := json.Unmarshal([]byte(gradingContext.Output), &doc); err != nil {
+			return &models.GraderResults{
+				Name:     jg.name,
+				Type:     string(TypeJSONSchema),
+				Score:    0.0,
+				Passed:   false,
+				Feedback: fmt.Sprintf("output is not valid JSON: %v", err),
+			}, nil
+		}
+
+		if err := jg.schema.Validate(doc); err != nil {
+			validationErr, ok := err.(*jsonschema.ValidationError)
+			var failures []string
+			if ok {
+				for _, cause := range validationErr.BasicOutput().Errors {
+					if cause.Error == "" {
+						continue
+					}
+					failures = append(failures, fmt.Sprintf("%s: %s", cause.KeywordLocation, cause.Error))
+				}
+			}
+			if len(failures) == 0 {
+				failures = []string{err.Error()}
+			}
+
+			return &models.GraderResults{
+				Name:     jg.name,
+				Type:     string(TypeJSONSchema),
+				Score:    0.0,
+				Passed:   false,
+				Feedback: strings.Join(failures, "; "),
+				Details:  map[string]any{"failures": failures},
+			}, nil
+		}
+
+		return &models.GraderResults{
+			Name:     jg.name,
+			Type:     string(TypeJSONSchema),
+			Score:    1.0,
+			Passed:   true,
+			Feedback: "output conforms to schema",
+		}, nil
+	})
+}
@@ -0,0 +1,64 @@
+package graders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPersonSchema = `{
+	"type": "object",
+	"required": ["name", "age"],
+	"properties": {
+		"name": {"type": "string"},
+		"age": {"type": "integer", "minimum": 0}
+	}
+}`
+
+func TestJSONSchemaGrader_Basic(t *testing.T) {
+	g, err := NewJSONSchemaGrader("test", testPersonSchema)
+	require.NoError(t, err)
+
+	require.Equal(t, TypeJSONSchema, g.Type())
+	require.Equal(t, "test", g.Name())
+}
+
+func TestJSONSchemaGrader_Grade(t *testing.T) {
+	g, err := NewJSONSchemaGrader("test", testPersonSchema)
+	require.NoError(t, err)
+
+	t.Run("valid document", func(t *testing.T) {
+		results, err := g.Grade(context.Background(), &Context{Output: `{"name": "Ada", "age": 36}`})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+		require.Equal(t, 1.0, results.Score)
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		results, err := g.Grade(context.Background(), &Context{Output: `{"name": "Ada"}`})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Equal(t, 0.0, results.Score)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		results, err := g.Grade(context.Background(), &Context{Output: `{"name": "Ada", "age": "old"}`})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+	})
+
+	t.Run("not valid json", func(t *testing.T) {
+		results, err := g.Grade(context.Background(), &Context{Output: `not json`})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Contains(t, results.Feedback, "not valid JSON")
+	})
+}
+
+func TestJSONSchemaGrader_InvalidSchema(t *testing.T) {
+	_, err := NewJSONSchemaGrader("test", `{"type": "not-a-real-type"`)
+	require.Error(t, err)
+}
+
+var _ Grader = (*JSONSchemaGrader)(nil)
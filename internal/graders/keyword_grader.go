@@ -0,0 +1,109 @@
+package graders
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spboyer/waza/internal/models"
+)
+
+// KeywordGrader checks for the literal presence or absence of keywords in the
+// candidate output. Unlike RegexGrader it matches keywords verbatim (no
+// pattern compilation) and supports per-keyword weighting so some keywords
+// can count for more of the score than others.
+type KeywordGrader struct {
+	name          string
+	mustInclude   []string
+	mustExclude   []string
+	caseSensitive bool
+	weights       map[string]float64
+}
+
+// NewKeywordGrader creates a KeywordGrader. weights maps a keyword (from
+// either mustInclude or mustExclude) to its contribution to the score;
+// keywords missing from weights default to a weight of 1.0.
+func NewKeywordGrader(name string, mustInclude, mustExclude []string, caseSensitive bool, weights map[string]float64) (*KeywordGrader, error) {
+	return &KeywordGrader{
+		name:          name,
+		mustInclude:   mustInclude,
+		mustExclude:   mustExclude,
+		caseSensitive: caseSensitive,
+		weights:       weights,
+	}, nil
+}
+
+func (kg *KeywordGrader) Name() string { return kg.name }
+func (kg *KeywordGrader) Type() Type   { return TypeKeyword }
+
+func (kg *KeywordGrader) weightFor(keyword string) float64 {
+	if w, ok := kg.weights[keyword]; ok {
+		return w
+	}
+	return 1.0
+}
+
+func (kg *KeywordGrader) Grade(ctx context.Context, gradingContext *Context) (*models.GraderResults, error) {
+	return measureTime(func() (*models.GraderResults, error) {
+		output := gradingContext.Output
+		if !kg.caseSensitive {
+			output = strings.ToLower(output)
+		}
+
+		var failures []string
+		totalWeight := 0.0
+		earnedWeight := 0.0
+
+		check := func(keyword string) string {
+			if kg.caseSensitive {
+				return keyword
+			}
+			return strings.ToLower(keyword)
+		}
+
+		for _, keyword := range kg.mustInclude {
+			weight := kg.weightFor(keyword)
+			totalWeight += weight
+
+			if strings.Contains(output, check(keyword)) {
+				earnedWeight += weight
+			} else {
+				failures = append(failures, fmt.Sprintf("Missing required keyword: %s", keyword))
+			}
+		}
+
+		for _, keyword := range kg.mustExclude {
+			weight := kg.weightFor(keyword)
+			totalWeight += weight
+
+			if strings.Contains(output, check(keyword)) {
+				failures = append(failures, fmt.Sprintf("Found forbidden keyword: %s", keyword))
+			} else {
+				earnedWeight += weight
+			}
+		}
+
+		score := 1.0
+		if totalWeight > 0 {
+			score = earnedWeight / totalWeight
+		}
+
+		feedback := "All keyword checks passed"
+		if len(failures) > 0 {
+			feedback = strings.Join(failures, "; ")
+		}
+
+		return &models.GraderResults{
+			Name:     kg.name,
+			Type:     string(TypeKeyword),
+			Score:    score,
+			Passed:   len(failures) == 0,
+			Feedback: feedback,
+			Details: map[string]any{
+				"must_include": kg.mustInclude,
+				"must_exclude": kg.mustExclude,
+				"failures":     failures,
+			},
+		}, nil
+	})
+}
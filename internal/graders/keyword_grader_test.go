@@ -0,0 +1,81 @@
+package graders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeywordGrader_Basic(t *testing.T) {
+	g, err := NewKeywordGrader("test", []string{"hello"}, nil, true, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, TypeKeyword, g.Type())
+	require.Equal(t, "test", g.Name())
+}
+
+func TestKeywordGrader_Grade(t *testing.T) {
+	t.Run("must_include keyword present", func(t *testing.T) {
+		g, err := NewKeywordGrader("test", []string{"hello", "world"}, nil, true, nil)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "hello world"})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+		require.Equal(t, 1.0, results.Score)
+	})
+
+	t.Run("must_include keyword missing", func(t *testing.T) {
+		g, err := NewKeywordGrader("test", []string{"hello", "missing"}, nil, true, nil)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "hello world"})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Equal(t, 0.5, results.Score)
+		require.Contains(t, results.Feedback, "Missing required keyword: missing")
+	})
+
+	t.Run("must_exclude keyword found", func(t *testing.T) {
+		g, err := NewKeywordGrader("test", nil, []string{"error"}, true, nil)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "an error occurred"})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Equal(t, 0.0, results.Score)
+	})
+
+	t.Run("case insensitive match", func(t *testing.T) {
+		g, err := NewKeywordGrader("test", []string{"HELLO"}, nil, false, nil)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "hello world"})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+	})
+
+	t.Run("per keyword weighting", func(t *testing.T) {
+		g, err := NewKeywordGrader("test", []string{"critical", "nice-to-have"}, nil, true,
+			map[string]float64{"critical": 3.0, "nice-to-have": 1.0})
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "critical info only"})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Equal(t, 0.75, results.Score)
+	})
+
+	t.Run("no keywords configured", func(t *testing.T) {
+		g, err := NewKeywordGrader("test", nil, nil, true, nil)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "anything"})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+		require.Equal(t, 1.0, results.Score)
+	})
+}
+
+var _ Grader = (*KeywordGrader)(nil)
@@ -0,0 +1,105 @@
+package graders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/spboyer/waza/internal/models"
+)
+
+// ProgramGrader runs an external command, feeding the candidate output on
+// stdin, and expects a JSON object of the form
+// {"score": 0.0-1.0, "passed": bool, "feedback": "..."} on stdout.
+type ProgramGrader struct {
+	name       string
+	command    string
+	args       []string
+	timeoutSec int
+}
+
+func NewProgramGrader(name, command string, args []string, timeoutSec int) (*ProgramGrader, error) {
+	if command == "" {
+		return nil, fmt.Errorf("program grader %q requires a 'command'", name)
+	}
+
+	if timeoutSec <= 0 {
+		timeoutSec = 30
+	}
+
+	return &ProgramGrader{
+		name:       name,
+		command:    command,
+		args:       args,
+		timeoutSec: timeoutSec,
+	}, nil
+}
+
+func (pg *ProgramGrader) Name() string { return pg.name }
+func (pg *ProgramGrader) Type() Type   { return TypeProgram }
+
+type programGraderOutput struct {
+	Score    float64 `json:"score"`
+	Passed   bool    `json:"passed"`
+	Feedback string  `json:"feedback"`
+}
+
+func (pg *ProgramGrader) Grade(ctx context.Context, gradingContext *Context) (*models.GraderResults, error) {
+	return measureTime(func() (*models.GraderResults, error) {
+		timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(pg.timeoutSec)*time.Second)
+		defer cancel()
+
+		cmd := exec.CommandContext(timeoutCtx, pg.command, pg.args...)
+		cmd.Stdin = bytes.NewReader([]byte(gradingContext.Output))
+
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		runErr := cmd.Run()
+
+		if timeoutCtx.Err() == context.DeadlineExceeded {
+			return &models.GraderResults{
+				Name:     pg.name,
+				Type:     string(TypeProgram),
+				Score:    0.0,
+				Passed:   false,
+				Feedback: fmt.Sprintf("program grader timed out after %ds", pg.timeoutSec),
+			}, nil
+		}
+
+		var out programGraderOutput
+		if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+			return &models.GraderResults{
+				Name:     pg.name,
+				Type:     string(TypeProgram),
+				Score:    0.0,
+				Passed:   false,
+				Feedback: fmt.Sprintf("failed to parse program output as JSON: %v (stderr: %s)", err, stderr.String()),
+			}, nil
+		}
+
+		if runErr != nil {
+			if out.Feedback == "" {
+				out.Feedback = fmt.Sprintf("program exited with error: %v", runErr)
+			}
+			out.Passed = false
+		}
+
+		return &models.GraderResults{
+			Name:     pg.name,
+			Type:     string(TypeProgram),
+			Score:    out.Score,
+			Passed:   out.Passed,
+			Feedback: out.Feedback,
+			Details: map[string]any{
+				"command": pg.command,
+				"args":    pg.args,
+				"stderr":  stderr.String(),
+			},
+		}, nil
+	})
+}
@@ -0,0 +1,74 @@
+package graders
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func skipIfNoShell(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("Skipping ProgramGrader tests that need a POSIX shell")
+	}
+}
+
+func TestProgramGrader_Basic(t *testing.T) {
+	g, err := NewProgramGrader("test", "sh", []string{"-c", "cat"}, 5)
+	require.NoError(t, err)
+
+	require.Equal(t, TypeProgram, g.Type())
+	require.Equal(t, "test", g.Name())
+}
+
+func TestProgramGrader_Grade(t *testing.T) {
+	skipIfNoShell(t)
+
+	t.Run("program reports pass", func(t *testing.T) {
+		g, err := NewProgramGrader("test", "sh", []string{"-c", `echo '{"score": 1.0, "passed": true, "feedback": "great"}'`}, 5)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "candidate output"})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+		require.Equal(t, 1.0, results.Score)
+		require.Equal(t, "great", results.Feedback)
+	})
+
+	t.Run("program reports fail", func(t *testing.T) {
+		g, err := NewProgramGrader("test", "sh", []string{"-c", `echo '{"score": 0.2, "passed": false, "feedback": "missing details"}'`}, 5)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "candidate output"})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Equal(t, 0.2, results.Score)
+	})
+
+	t.Run("program produces invalid json", func(t *testing.T) {
+		g, err := NewProgramGrader("test", "sh", []string{"-c", `echo 'not json'`}, 5)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "candidate output"})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Contains(t, results.Feedback, "failed to parse program output")
+	})
+
+	t.Run("program exits non-zero", func(t *testing.T) {
+		g, err := NewProgramGrader("test", "sh", []string{"-c", `echo '{"score": 0, "passed": false}'; exit 1`}, 5)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "candidate output"})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+	})
+}
+
+func TestProgramGrader_RequiresCommand(t *testing.T) {
+	_, err := NewProgramGrader("test", "", nil, 5)
+	require.Error(t, err)
+}
+
+var _ Grader = (*ProgramGrader)(nil)
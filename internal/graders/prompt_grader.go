@@ -0,0 +1,117 @@
+package graders
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spboyer/waza/internal/execution"
+	"github.com/spboyer/waza/internal/models"
+)
+
+// PromptGrader scores candidate output by asking an execution engine to
+// evaluate it against a rubric prompt. The engine is expected to respond
+// with a line of the form "SCORE: <0-1 float>" somewhere in its final
+// output; anything else in the response is carried through as feedback.
+type PromptGrader struct {
+	name      string
+	engine    execution.AgentEngine
+	rubric    string
+	modelID   string
+	threshold float64
+}
+
+var scoreLinePattern = regexp.MustCompile(`(?i)score\s*:\s*([01](?:\.\d+)?|\.\d+)`)
+
+// NewPromptGrader creates a PromptGrader. threshold is the minimum score
+// (0-1) required for Passed to be true; it defaults to 0.7 when <= 0.
+func NewPromptGrader(name string, engine execution.AgentEngine, rubric string, threshold float64) (*PromptGrader, error) {
+	if engine == nil {
+		return nil, fmt.Errorf("prompt grader %q requires an execution engine", name)
+	}
+	if rubric == "" {
+		return nil, fmt.Errorf("prompt grader %q requires a 'rubric'", name)
+	}
+
+	if threshold <= 0 {
+		threshold = 0.7
+	}
+
+	return &PromptGrader{
+		name:      name,
+		engine:    engine,
+		rubric:    rubric,
+		threshold: threshold,
+	}, nil
+}
+
+func (pg *PromptGrader) Name() string { return pg.name }
+func (pg *PromptGrader) Type() Type   { return TypePrompt }
+
+func (pg *PromptGrader) Grade(ctx context.Context, gradingContext *Context) (*models.GraderResults, error) {
+	return measureTime(func() (*models.GraderResults, error) {
+		prompt := fmt.Sprintf(
+			"%s\n\nCandidate output:\n---\n%s\n---\n\nRespond with a line \"SCORE: <0-1>\" followed by brief feedback.",
+			pg.rubric, gradingContext.Output,
+		)
+
+		resp, err := pg.engine.Execute(ctx, &execution.ExecutionRequest{
+			TestID:  pg.name,
+			Message: prompt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("prompt grader %q failed to execute rubric prompt: %w", pg.name, err)
+		}
+
+		score, feedback, err := parseScoreResponse(resp.FinalOutput)
+		if err != nil {
+			return &models.GraderResults{
+				Name:     pg.name,
+				Type:     string(TypePrompt),
+				Score:    0.0,
+				Passed:   false,
+				Feedback: fmt.Sprintf("could not parse rubric response: %v", err),
+				Details:  map[string]any{"raw_response": resp.FinalOutput},
+			}, nil
+		}
+
+		return &models.GraderResults{
+			Name:     pg.name,
+			Type:     string(TypePrompt),
+			Score:    score,
+			Passed:   score >= pg.threshold,
+			Feedback: feedback,
+			Details: map[string]any{
+				"threshold":    pg.threshold,
+				"raw_response": resp.FinalOutput,
+			},
+		}, nil
+	})
+}
+
+func parseScoreResponse(response string) (float64, string, error) {
+	matches := scoreLinePattern.FindStringSubmatchIndex(response)
+	if matches == nil {
+		return 0, "", fmt.Errorf("no \"SCORE: <0-1>\" line found in response")
+	}
+
+	scoreText := response[matches[2]:matches[3]]
+	score, err := strconv.ParseFloat(scoreText, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid score value %q: %w", scoreText, err)
+	}
+	if score < 0 {
+		score = 0
+	} else if score > 1 {
+		score = 1
+	}
+
+	feedback := strings.TrimSpace(response[:matches[0]] + response[matches[1]:])
+	if feedback == "" {
+		feedback = fmt.Sprintf("score: %.2f", score)
+	}
+
+	return score, feedback, nil
+}
@@ -0,0 +1,88 @@
+package graders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spboyer/waza/internal/execution"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEngine is a minimal execution.AgentEngine stub that returns a
+// pre-scripted response, used so PromptGrader tests don't depend on a real
+// model backend.
+type fakeEngine struct {
+	response string
+	err      error
+}
+
+func (f *fakeEngine) Initialize(ctx context.Context) error { return nil }
+
+func (f *fakeEngine) Execute(ctx context.Context, req *execution.ExecutionRequest) (*execution.ExecutionResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &execution.ExecutionResponse{FinalOutput: f.response, Success: true}, nil
+}
+
+func (f *fakeEngine) Shutdown(ctx context.Context) error { return nil }
+
+func TestPromptGrader_Basic(t *testing.T) {
+	g, err := NewPromptGrader("test", &fakeEngine{response: "SCORE: 0.9\ngood job"}, "Does the answer address the prompt?", 0.7)
+	require.NoError(t, err)
+
+	require.Equal(t, TypePrompt, g.Type())
+	require.Equal(t, "test", g.Name())
+}
+
+func TestPromptGrader_Grade(t *testing.T) {
+	t.Run("score above threshold passes", func(t *testing.T) {
+		g, err := NewPromptGrader("test", &fakeEngine{response: "SCORE: 0.9\nstrong response"}, "rubric", 0.7)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "the answer"})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+		require.Equal(t, 0.9, results.Score)
+	})
+
+	t.Run("score below threshold fails", func(t *testing.T) {
+		g, err := NewPromptGrader("test", &fakeEngine{response: "SCORE: 0.3\nweak response"}, "rubric", 0.7)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "the answer"})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Equal(t, 0.3, results.Score)
+	})
+
+	t.Run("unparseable response", func(t *testing.T) {
+		g, err := NewPromptGrader("test", &fakeEngine{response: "I didn't follow instructions"}, "rubric", 0.7)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "the answer"})
+		require.NoError(t, err)
+		require.False(t, results.Passed)
+		require.Contains(t, results.Feedback, "could not parse rubric response")
+	})
+
+	t.Run("default threshold", func(t *testing.T) {
+		g, err := NewPromptGrader("test", &fakeEngine{response: "SCORE: 0.7"}, "rubric", 0)
+		require.NoError(t, err)
+
+		results, err := g.Grade(context.Background(), &Context{Output: "the answer"})
+		require.NoError(t, err)
+		require.True(t, results.Passed)
+	})
+}
+
+func TestPromptGrader_RequiresEngineAndRubric(t *testing.T) {
+	_, err := NewPromptGrader("test", nil, "rubric", 0.7)
+	require.Error(t, err)
+
+	_, err = NewPromptGrader("test", &fakeEngine{}, "", 0.7)
+	require.Error(t, err)
+}
+
+var _ Grader = (*PromptGrader)(nil)
+var _ execution.AgentEngine = (*fakeEngine)(nil)
@@ -174,7 +174,7 @@ func TestRegexGrader_ViaCreate(t *testing.T) {
 		g, err := Create(TypeRegex, "from-create", map[string]any{
 			"must_match":     []string{`hello`},
 			"must_not_match": []string{`bye`},
-		})
+		}, nil)
 		require.NoError(t, err)
 		require.Equal(t, "from-create", g.Name())
 		require.Equal(t, TypeRegex, g.Type())
@@ -0,0 +1,49 @@
+package graders
+
+import (
+	"context"
+
+	_ "embed"
+)
+
+//go:embed data/eval_wrapper.sh
+var evalWrapperSh string
+
+// bashRuntime evaluates assertions as bash conditional expressions via a
+// subprocess, so assertions can be written without Python or Node.js
+// installed (jq is required). interpreter defaults to "bash" but can be
+// overridden via SetInterpreter.
+type bashRuntime struct{ interpreter string }
+
+func (r *bashRuntime) SetInterpreter(bin string) { r.interpreter = bin }
+
+func (r bashRuntime) Prepare(ctx context.Context, assertions []string) (Program, error) {
+	scriptPath, cleanup, err := writeEmbeddedScript("temp-bash-*.sh", evalWrapperSh)
+	if err != nil {
+		return nil, err
+	}
+
+	command := r.interpreter
+	if command == "" {
+		command = "bash"
+	}
+
+	return &bashProgram{
+		subprocessProgram: subprocessProgram{
+			command:    command,
+			scriptPath: scriptPath,
+			assertions: assertions,
+		},
+		cleanup: cleanup,
+	}, nil
+}
+
+type bashProgram struct {
+	subprocessProgram
+	cleanup func()
+}
+
+func (p *bashProgram) Eval(ctx context.Context, ctxVars map[string]any) (ScriptResult, error) {
+	defer p.cleanup()
+	return p.subprocessProgram.Eval(ctx, ctxVars)
+}
@@ -0,0 +1,59 @@
+package graders
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+)
+
+// exprRuntime evaluates assertions with expr-lang/expr, a pure-Go expression
+// engine, so assertions run without any interpreter installed.
+type exprRuntime struct{}
+
+func (exprRuntime) Prepare(ctx context.Context, assertions []string) (Program, error) {
+	programs := make([]*vm.Program, len(assertions))
+	for i, assertion := range assertions {
+		program, err := expr.Compile(assertion, expr.AllowUndefinedVariables())
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile expr assertion %q: %w", assertion, err)
+		}
+		programs[i] = program
+	}
+
+	return &exprProgram{assertions: assertions, programs: programs}, nil
+}
+
+type exprProgram struct {
+	assertions []string
+	programs   []*vm.Program
+}
+
+func (p *exprProgram) Eval(ctx context.Context, ctxVars map[string]any) (ScriptResult, error) {
+	result := ScriptResult{
+		Passed:   make([]bool, len(p.programs)),
+		Messages: make([]string, len(p.programs)),
+	}
+
+	for i, program := range p.programs {
+		out, err := expr.Run(program, ctxVars)
+		if err != nil {
+			result.Messages[i] = fmt.Sprintf("assertion raised an error: %v", err)
+			continue
+		}
+
+		passed, ok := out.(bool)
+		if !ok {
+			result.Messages[i] = fmt.Sprintf("assertion %q did not evaluate to a bool", p.assertions[i])
+			continue
+		}
+
+		result.Passed[i] = passed
+		if !passed {
+			result.Messages[i] = fmt.Sprintf("assertion failed: %s", p.assertions[i])
+		}
+	}
+
+	return result, nil
+}
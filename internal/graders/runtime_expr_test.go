@@ -0,0 +1,45 @@
+package graders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExprRuntime(t *testing.T) {
+	runtime := exprRuntime{}
+
+	program, err := runtime.Prepare(context.Background(), []string{
+		`output == "hello world"`,
+		`len(output) > 100`,
+	})
+	require.NoError(t, err)
+
+	result, err := program.Eval(context.Background(), map[string]any{
+		"output": "hello world",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []bool{true, false}, result.Passed)
+	require.Empty(t, result.Messages[0])
+	require.Contains(t, result.Messages[1], "assertion failed")
+}
+
+func TestExprRuntime_CompileError(t *testing.T) {
+	runtime := exprRuntime{}
+
+	_, err := runtime.Prepare(context.Background(), []string{"this is not valid ("})
+	require.Error(t, err)
+}
+
+func TestInlineScriptGrader_ExprLanguage(t *testing.T) {
+	grader, err := NewInlineScriptGrader("test", LanguageExpr, []string{
+		`output == "hello world"`,
+	}, "")
+	require.NoError(t, err)
+
+	results, err := grader.Grade(context.Background(), &Context{Output: "hello world"})
+	require.NoError(t, err)
+	require.True(t, results.Passed)
+}
@@ -0,0 +1,49 @@
+package graders
+
+import (
+	"context"
+
+	_ "embed"
+)
+
+//go:embed data/eval_wrapper.js
+var evalWrapperJS string
+
+// nodeRuntime evaluates assertions as JavaScript expressions via a Node.js
+// subprocess, so assertions can be written without a Python interpreter
+// installed. interpreter defaults to "node" but can be overridden (e.g.
+// "/usr/bin/env node") via SetInterpreter.
+type nodeRuntime struct{ interpreter string }
+
+func (r *nodeRuntime) SetInterpreter(bin string) { r.interpreter = bin }
+
+func (r nodeRuntime) Prepare(ctx context.Context, assertions []string) (Program, error) {
+	scriptPath, cleanup, err := writeEmbeddedScript("temp-node-*.js", evalWrapperJS)
+	if err != nil {
+		return nil, err
+	}
+
+	command := r.interpreter
+	if command == "" {
+		command = "node"
+	}
+
+	return &nodeProgram{
+		subprocessProgram: subprocessProgram{
+			command:    command,
+			scriptPath: scriptPath,
+			assertions: assertions,
+		},
+		cleanup: cleanup,
+	}, nil
+}
+
+type nodeProgram struct {
+	subprocessProgram
+	cleanup func()
+}
+
+func (p *nodeProgram) Eval(ctx context.Context, ctxVars map[string]any) (ScriptResult, error) {
+	defer p.cleanup()
+	return p.subprocessProgram.Eval(ctx, ctxVars)
+}
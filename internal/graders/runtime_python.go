@@ -0,0 +1,60 @@
+package graders
+
+import (
+	"context"
+
+	_ "embed"
+)
+
+//go:embed data/eval_wrapper.py
+var evalWrapperPy string
+
+// pythonRuntime evaluates assertions as Python expressions via a subprocess,
+// the grader's original behavior. interpreter defaults to "python" but can
+// be overridden (e.g. "python3", a project-local ".venv/bin/python") via
+// SetInterpreter.
+type pythonRuntime struct{ interpreter string }
+
+func (r *pythonRuntime) SetInterpreter(bin string) { r.interpreter = bin }
+
+func (r pythonRuntime) Prepare(ctx context.Context, assertions []string) (Program, error) {
+	command := r.interpreter
+	if command == "" {
+		command = "python"
+	}
+
+	// The persistent interpreter pays its startup cost once per benchmark
+	// run rather than once per Grade call; see runtime_python_persistent.go.
+	if persistentPythonEnabled() {
+		return &persistentPythonProgram{
+			runner:     getPersistentPythonRunner(command),
+			assertions: assertions,
+		}, nil
+	}
+
+	scriptPath, cleanup, err := writeEmbeddedScript("temp-python-*.py", evalWrapperPy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pythonProgram{
+		subprocessProgram: subprocessProgram{
+			command:    command,
+			scriptPath: scriptPath,
+			assertions: assertions,
+		},
+		cleanup: cleanup,
+	}, nil
+}
+
+// pythonProgram wraps subprocessProgram so the temp wrapper file written in
+// Prepare is cleaned up once it's no longer needed.
+type pythonProgram struct {
+	subprocessProgram
+	cleanup func()
+}
+
+func (p *pythonProgram) Eval(ctx context.Context, ctxVars map[string]any) (ScriptResult, error) {
+	defer p.cleanup()
+	return p.subprocessProgram.Eval(ctx, ctxVars)
+}
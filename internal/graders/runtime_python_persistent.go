@@ -0,0 +1,244 @@
+package graders
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	_ "embed"
+)
+
+//go:embed data/eval_wrapper_persistent.py
+var evalWrapperPersistentPy string
+
+// persistentPythonEnabled reports whether InlineScriptGrader should reuse a
+// long-lived Python interpreter across Grade calls instead of spawning one
+// per call. Set WAZA_PERSISTENT_PYTHON=0 to fall back to the original
+// per-invocation behavior (e.g. if the persistent interpreter's state
+// leaking between assertions is ever a concern).
+func persistentPythonEnabled() bool {
+	return os.Getenv("WAZA_PERSISTENT_PYTHON") != "0"
+}
+
+var (
+	persistentPythonMu      sync.Mutex
+	persistentPythonRunners = map[string]*persistentPythonRunner{}
+)
+
+// getPersistentPythonRunner returns the shared runner for the given
+// interpreter binary, creating it on first use. The process itself isn't
+// started until the first eval call.
+func getPersistentPythonRunner(interpreter string) *persistentPythonRunner {
+	persistentPythonMu.Lock()
+	defer persistentPythonMu.Unlock()
+
+	r, ok := persistentPythonRunners[interpreter]
+	if !ok {
+		r = &persistentPythonRunner{interpreter: interpreter}
+		persistentPythonRunners[interpreter] = r
+	}
+	return r
+}
+
+// ShutdownRuntimes tears down every long-lived interpreter process started
+// by graders during this benchmark run (currently just the persistent
+// Python interpreter, one per distinct interpreter binary in use). Callers
+// should run it once at benchmark end, the same way they shut down the
+// execution engine.
+func ShutdownRuntimes(ctx context.Context) {
+	persistentPythonMu.Lock()
+	runners := make([]*persistentPythonRunner, 0, len(persistentPythonRunners))
+	for _, r := range persistentPythonRunners {
+		runners = append(runners, r)
+	}
+	persistentPythonRunners = map[string]*persistentPythonRunner{}
+	persistentPythonMu.Unlock()
+
+	for _, r := range runners {
+		r.shutdown()
+	}
+}
+
+// persistentPythonRunner keeps one Python subprocess alive across many
+// Eval calls, communicating newline-delimited JSON over stdin/stdout
+// instead of exec'ing a fresh interpreter per call. Requests are
+// serialized by mu since the wrapper script processes one line at a time.
+type persistentPythonRunner struct {
+	mu          sync.Mutex
+	interpreter string
+	scriptPath  string
+	cleanup     func()
+
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	stdout  *bufio.Reader
+	crashed bool
+	lastErr string
+	done    chan struct{}
+}
+
+// start launches the interpreter. Callers must hold r.mu. A plain
+// exec.Command (not CommandContext) is used deliberately: the process
+// outlives any single Eval call's context and is torn down explicitly by
+// shutdown, not by the first caller's ctx being canceled.
+func (r *persistentPythonRunner) start() error {
+	if r.scriptPath == "" {
+		scriptPath, cleanup, err := writeEmbeddedScript("temp-python-persistent-*.py", evalWrapperPersistentPy)
+		if err != nil {
+			return err
+		}
+		r.scriptPath = scriptPath
+		r.cleanup = cleanup
+	}
+
+	command := r.interpreter
+	if command == "" {
+		command = "python"
+	}
+
+	cmd := exec.Command(command, r.scriptPath)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	r.cmd = cmd
+	r.stdin = stdin
+	r.stdout = bufio.NewReader(stdout)
+	r.crashed = false
+	r.lastErr = ""
+
+	done := make(chan struct{})
+	r.done = done
+
+	// Health check: once the process exits (crash, OOM-kill, ...) mark the
+	// runner unhealthy so the next eval respawns it instead of blocking
+	// forever on a pipe nobody is reading/writing anymore.
+	go func() {
+		defer close(done)
+		stderrOutput, _ := io.ReadAll(stderr)
+		_ = cmd.Wait()
+
+		r.mu.Lock()
+		r.crashed = true
+		r.lastErr = strings.TrimSpace(string(stderrOutput))
+		r.mu.Unlock()
+	}()
+
+	return nil
+}
+
+func (r *persistentPythonRunner) healthy() bool {
+	return r.cmd != nil && !r.crashed
+}
+
+func (r *persistentPythonRunner) eval(assertions []string, ctxVars map[string]any) (ScriptResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.healthy() {
+		if err := r.start(); err != nil {
+			return ScriptResult{}, fmt.Errorf("failed to start persistent python interpreter: %w", err)
+		}
+	}
+
+	input := scriptInput{
+		Output:     stringVar(ctxVars, "output"),
+		Transcript: ctxVars["transcript"],
+		ToolCalls:  ctxVars["tool_calls"],
+		Metadata:   ctxVars["metadata"],
+		Outcome:    ctxVars["outcome"],
+		DurationMS: int64Var(ctxVars, "duration_ms"),
+		Assertions: assertions,
+	}
+
+	line, err := json.Marshal(input)
+	if err != nil {
+		return ScriptResult{}, err
+	}
+	line = append(line, '\n')
+
+	if _, err := r.stdin.Write(line); err != nil {
+		// Most likely the interpreter crashed between calls; respawn once
+		// and retry rather than failing every grader sharing this runner.
+		if startErr := r.start(); startErr != nil {
+			return ScriptResult{}, fmt.Errorf("persistent python interpreter crashed (%s) and failed to restart: %w", r.lastErr, startErr)
+		}
+		if _, err := r.stdin.Write(line); err != nil {
+			return ScriptResult{}, fmt.Errorf("failed to write to persistent python interpreter: %w", err)
+		}
+	}
+
+	respLine, err := r.stdout.ReadString('\n')
+	if err != nil {
+		r.crashed = true
+		return ScriptResult{}, fmt.Errorf("persistent python interpreter crashed while evaluating (%s): %w", r.lastErr, err)
+	}
+
+	var out scriptOutput
+	if err := json.Unmarshal([]byte(respLine), &out); err != nil {
+		return ScriptResult{}, fmt.Errorf("failed to deserialize response (%s) from persistent python interpreter: %w", respLine, err)
+	}
+
+	result := ScriptResult{
+		Passed:   make([]bool, len(out.Results)),
+		Messages: make([]string, len(out.Results)),
+	}
+	for i, entry := range out.Results {
+		result.Passed[i] = entry.Passed
+		result.Messages[i] = entry.Message
+	}
+	return result, nil
+}
+
+func (r *persistentPythonRunner) shutdown() {
+	r.mu.Lock()
+	stdin := r.stdin
+	done := r.done
+	cleanup := r.cleanup
+	r.cmd = nil
+	r.stdin = nil
+	r.stdout = nil
+	r.done = nil
+	r.mu.Unlock()
+
+	if stdin != nil {
+		_ = stdin.Close()
+	}
+	if done != nil {
+		<-done
+	}
+	if cleanup != nil {
+		cleanup()
+	}
+}
+
+// persistentPythonProgram is the Program pythonRuntime.Prepare returns when
+// persistentPythonEnabled(), deferring every Eval to the shared runner for
+// this interpreter binary instead of spawning a subprocess per call.
+type persistentPythonProgram struct {
+	runner     *persistentPythonRunner
+	assertions []string
+}
+
+func (p *persistentPythonProgram) Eval(ctx context.Context, ctxVars map[string]any) (ScriptResult, error) {
+	return p.runner.eval(p.assertions, ctxVars)
+}
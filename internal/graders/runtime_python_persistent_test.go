@@ -0,0 +1,55 @@
+package graders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistentPythonRunner_ReusesProcessAcrossCalls(t *testing.T) {
+	skipIfNoPython(t)
+
+	runner := getPersistentPythonRunner("python")
+	t.Cleanup(func() { ShutdownRuntimes(context.Background()) })
+
+	for i := 0; i < 3; i++ {
+		result, err := runner.eval([]string{`"hello" in output`}, map[string]any{"output": "hello world"})
+		require.NoError(t, err)
+		require.Equal(t, []bool{true}, result.Passed)
+	}
+
+	require.True(t, runner.healthy())
+}
+
+func TestPersistentPythonRunner_RespawnsAfterCrash(t *testing.T) {
+	skipIfNoPython(t)
+
+	runner := getPersistentPythonRunner("python")
+	t.Cleanup(func() { ShutdownRuntimes(context.Background()) })
+
+	_, err := runner.eval([]string{"1 == 1"}, map[string]any{"output": ""})
+	require.NoError(t, err)
+
+	runner.mu.Lock()
+	done := runner.done
+	_ = runner.cmd.Process.Kill()
+	runner.mu.Unlock()
+	<-done
+
+	result, err := runner.eval([]string{"1 == 1"}, map[string]any{"output": ""})
+	require.NoError(t, err)
+	require.Equal(t, []bool{true}, result.Passed)
+}
+
+func TestInlineScriptGrader_PersistentPythonFallback(t *testing.T) {
+	skipIfNoPython(t)
+	t.Setenv("WAZA_PERSISTENT_PYTHON", "0")
+
+	grader, err := NewInlineScriptGrader("test", LanguagePython, []string{"1 == 1"}, "")
+	require.NoError(t, err)
+
+	results, err := grader.Grade(context.Background(), &Context{})
+	require.NoError(t, err)
+	require.True(t, results.Passed)
+}
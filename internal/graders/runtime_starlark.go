@@ -0,0 +1,117 @@
+package graders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.starlark.net/starlark"
+)
+
+// starlarkRuntime evaluates assertions as sandboxed Starlark expressions: no
+// filesystem or network access, no goroutines, bounded execution. It's the
+// option for users who want a pure-Go engine but don't trust expr's fuller
+// language surface.
+type starlarkRuntime struct{}
+
+func (starlarkRuntime) Prepare(ctx context.Context, assertions []string) (Program, error) {
+	return &starlarkProgram{assertions: assertions}, nil
+}
+
+type starlarkProgram struct {
+	assertions []string
+}
+
+func (p *starlarkProgram) Eval(ctx context.Context, ctxVars map[string]any) (ScriptResult, error) {
+	globals, err := toStarlarkGlobals(ctxVars)
+	if err != nil {
+		return ScriptResult{}, err
+	}
+
+	thread := &starlark.Thread{Name: "inline-script-grader"}
+
+	result := ScriptResult{
+		Passed:   make([]bool, len(p.assertions)),
+		Messages: make([]string, len(p.assertions)),
+	}
+
+	for i, assertion := range p.assertions {
+		value, err := starlark.Eval(thread, "<assertion>", assertion, globals)
+		if err != nil {
+			result.Messages[i] = fmt.Sprintf("assertion raised an error: %v", err)
+			continue
+		}
+
+		passed := bool(value.Truth())
+		result.Passed[i] = passed
+		if !passed {
+			result.Messages[i] = fmt.Sprintf("assertion failed: %s", assertion)
+		}
+	}
+
+	return result, nil
+}
+
+func toStarlarkGlobals(vars map[string]any) (starlark.StringDict, error) {
+	globals := starlark.StringDict{}
+	for k, v := range vars {
+		value, err := toStarlarkValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %q to a starlark value: %w", k, err)
+		}
+		globals[k] = value
+	}
+	return globals, nil
+}
+
+// toStarlarkValue converts a Go value into its Starlark equivalent. Typed
+// structs (transcript entries, tool calls, ...) fall through to a JSON
+// round-trip so they arrive as plain Starlark dicts/lists.
+func toStarlarkValue(v any) (starlark.Value, error) {
+	switch val := v.(type) {
+	case nil:
+		return starlark.None, nil
+	case bool:
+		return starlark.Bool(val), nil
+	case string:
+		return starlark.String(val), nil
+	case int:
+		return starlark.MakeInt(val), nil
+	case int64:
+		return starlark.MakeInt64(val), nil
+	case float64:
+		return starlark.Float(val), nil
+	case map[string]any:
+		dict := starlark.NewDict(len(val))
+		for k, item := range val {
+			itemValue, err := toStarlarkValue(item)
+			if err != nil {
+				return nil, err
+			}
+			if err := dict.SetKey(starlark.String(k), itemValue); err != nil {
+				return nil, err
+			}
+		}
+		return dict, nil
+	case []any:
+		elems := make([]starlark.Value, len(val))
+		for i, item := range val {
+			itemValue, err := toStarlarkValue(item)
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = itemValue
+		}
+		return starlark.NewList(elems), nil
+	default:
+		raw, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		var generic any
+		if err := json.Unmarshal(raw, &generic); err != nil {
+			return nil, err
+		}
+		return toStarlarkValue(generic)
+	}
+}
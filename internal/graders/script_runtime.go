@@ -0,0 +1,78 @@
+package graders
+
+import (
+	"context"
+	"fmt"
+)
+
+// ScriptResult is what a Program reports after evaluating one set of
+// assertions: a pass/fail verdict and an optional message for each
+// assertion, in the same order they were passed to Prepare.
+type ScriptResult struct {
+	Passed   []bool
+	Messages []string
+}
+
+// Program is an assertion set a ScriptRuntime has prepared for evaluation.
+// Runtimes that compile or otherwise do per-assertion setup (an expr-lang
+// program, ...) do that work once in Prepare and reuse it across every Eval
+// call for the same grading run; the starlark runtime parses each assertion
+// at Eval time.
+type Program interface {
+	// Eval runs the prepared assertions against ctxVars, the JSON-shaped
+	// contract ({output, transcript, tool_calls, metadata}) every runtime
+	// receives identically, and reports a pass/fail + message per assertion.
+	Eval(ctx context.Context, ctxVars map[string]any) (ScriptResult, error)
+}
+
+// ScriptRuntime prepares a set of inline-script assertions for a given
+// language so InlineScriptGrader doesn't need to know how any particular
+// language evaluates them.
+type ScriptRuntime interface {
+	Prepare(ctx context.Context, assertions []string) (Program, error)
+}
+
+// RuntimeFactory constructs a fresh ScriptRuntime. Runtimes are stateless
+// across grading runs, so a factory (rather than a shared instance) keeps
+// third-party runtimes from needing to worry about concurrent Prepare calls.
+type RuntimeFactory func() ScriptRuntime
+
+var runtimeRegistry = map[Language]RuntimeFactory{}
+
+// RegisterRuntime makes a ScriptRuntime available to InlineScriptGrader under
+// the given language name, so YAML graders[].config.language can select it.
+// Third parties can call this from an init() to plug in their own runtimes.
+func RegisterRuntime(lang Language, factory RuntimeFactory) {
+	runtimeRegistry[lang] = factory
+}
+
+// interpreterOverrider is implemented by subprocess-backed runtimes (Python,
+// Node, Bash) whose interpreter binary graders[].config.interpreter may
+// override, e.g. "python3" or a project-local ".venv/bin/python". Runtimes
+// with nothing to exec (expr, starlark) don't implement it.
+type interpreterOverrider interface {
+	SetInterpreter(bin string)
+}
+
+func newRuntime(lang Language, interpreter string) (ScriptRuntime, error) {
+	factory, ok := runtimeRegistry[lang]
+	if !ok {
+		return nil, fmt.Errorf("language '%s' is not yet supported with inline scripts", lang)
+	}
+
+	rt := factory()
+	if interpreter != "" {
+		if o, ok := rt.(interpreterOverrider); ok {
+			o.SetInterpreter(interpreter)
+		}
+	}
+	return rt, nil
+}
+
+func init() {
+	RegisterRuntime(LanguagePython, func() ScriptRuntime { return &pythonRuntime{} })
+	RegisterRuntime(LanguageNode, func() ScriptRuntime { return &nodeRuntime{} })
+	RegisterRuntime(LanguageBash, func() ScriptRuntime { return &bashRuntime{} })
+	RegisterRuntime(LanguageExpr, func() ScriptRuntime { return &exprRuntime{} })
+	RegisterRuntime(LanguageStarlark, func() ScriptRuntime { return &starlarkRuntime{} })
+}
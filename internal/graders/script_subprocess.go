@@ -0,0 +1,126 @@
+package graders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// scriptInput is the shared JSON contract every subprocess runtime (Python,
+// Node.js) receives on stdin: the grading context plus the assertions to
+// evaluate against it.
+type scriptInput struct {
+	Output     string   `json:"output"`
+	Transcript any      `json:"transcript"`
+	ToolCalls  any      `json:"tool_calls"`
+	Metadata   any      `json:"metadata"`
+	Outcome    any      `json:"outcome"`
+	DurationMS int64    `json:"duration_ms"`
+	Assertions []string `json:"assertions"`
+}
+
+// scriptOutputEntry is one assertion's verdict, as every wrapper script
+// writes it back on stdout.
+type scriptOutputEntry struct {
+	Passed  bool   `json:"passed"`
+	Message string `json:"message"`
+}
+
+type scriptOutput struct {
+	Results []scriptOutputEntry `json:"results"`
+}
+
+// subprocessProgram is the Program every subprocess-backed runtime returns:
+// it just remembers the assertions and defers to runSubprocessScript at Eval
+// time, since these runtimes have nothing to precompile.
+type subprocessProgram struct {
+	command    string
+	scriptPath string
+	assertions []string
+}
+
+func (p *subprocessProgram) Eval(ctx context.Context, ctxVars map[string]any) (ScriptResult, error) {
+	input := scriptInput{
+		Output:     stringVar(ctxVars, "output"),
+		Transcript: ctxVars["transcript"],
+		ToolCalls:  ctxVars["tool_calls"],
+		Metadata:   ctxVars["metadata"],
+		Outcome:    ctxVars["outcome"],
+		DurationMS: int64Var(ctxVars, "duration_ms"),
+		Assertions: p.assertions,
+	}
+
+	inputJSON, err := json.MarshalIndent(input, "  ", "  ")
+	if err != nil {
+		return ScriptResult{}, err
+	}
+
+	// p.command may carry leading arguments (e.g. "/usr/bin/env node"), so
+	// split it on whitespace and append the wrapper script path.
+	commandParts := strings.Fields(p.command)
+	cmd := exec.CommandContext(ctx, commandParts[0], append(commandParts[1:], p.scriptPath)...)
+	cmd.Stdin = bytes.NewReader(inputJSON)
+	cmd.Stderr = os.Stderr
+
+	outputBytes, err := cmd.Output()
+	if err != nil {
+		return ScriptResult{}, fmt.Errorf("failed to execute inline script for assertions (%s): %w", string(outputBytes), err)
+	}
+
+	var out scriptOutput
+	if err := json.Unmarshal(outputBytes, &out); err != nil {
+		return ScriptResult{}, fmt.Errorf("failed to deserialize output (%s) from assertions: %w", string(outputBytes), err)
+	}
+
+	result := ScriptResult{
+		Passed:   make([]bool, len(out.Results)),
+		Messages: make([]string, len(out.Results)),
+	}
+	for i, entry := range out.Results {
+		result.Passed[i] = entry.Passed
+		result.Messages[i] = entry.Message
+	}
+	return result, nil
+}
+
+func stringVar(vars map[string]any, key string) string {
+	s, _ := vars[key].(string)
+	return s
+}
+
+func int64Var(vars map[string]any, key string) int64 {
+	switch v := vars[key].(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	default:
+		return 0
+	}
+}
+
+// writeEmbeddedScript writes an embedded wrapper script to a temp file so
+// the interpreter subprocess can be pointed at a real path, and returns a
+// cleanup func to remove it.
+func writeEmbeddedScript(pattern, contents string) (path string, cleanup func(), err error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if _, err := f.Write([]byte(contents)); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", nil, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", nil, err
+	}
+
+	return f.Name(), func() { os.Remove(f.Name()) }, nil
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BenchmarkSpec represents a complete evaluation specification
+type BenchmarkSpec struct {
+	Name        string           `yaml:"name"`
+	Description string           `yaml:"description,omitempty"`
+	SkillName   string           `yaml:"skill"`
+	Version     string           `yaml:"version"`
+	Config      Config           `yaml:"config"`
+	Graders     []GraderConfig   `yaml:"graders"`
+	Metrics     []MeasurementDef `yaml:"metrics"`
+	Tasks       []string         `yaml:"tasks"`
+}
+
+// Config controls execution behavior
+type Config struct {
+	RunsPerTest int    `yaml:"trials_per_task"`
+	TimeoutSec  int    `yaml:"timeout_seconds"`
+	Concurrent  bool   `yaml:"parallel"`
+	Workers     int    `yaml:"max_workers,omitempty"`
+	StopOnError bool   `yaml:"fail_fast,omitempty"`
+	EngineType  string `yaml:"executor"`
+	ModelID     string `yaml:"model"`
+}
+
+// GraderConfig defines a validator/grader
+type GraderConfig struct {
+	Kind       string         `yaml:"type"`
+	Identifier string         `yaml:"name"`
+	ScriptPath string         `yaml:"script,omitempty"`
+	Rubric     string         `yaml:"rubric,omitempty"`
+	ModelID    string         `yaml:"model,omitempty"`
+	Parameters map[string]any `yaml:"config,omitempty"`
+}
+
+// MeasurementDef defines a metric
+type MeasurementDef struct {
+	Identifier string  `yaml:"name"`
+	Weight     float64 `yaml:"weight"`
+	Cutoff     float64 `yaml:"threshold"`
+	Enabled    bool    `yaml:"enabled,omitempty"`
+	Desc       string  `yaml:"description,omitempty"`
+}
+
+// LoadBenchmarkSpec loads a spec from a YAML file
+func LoadBenchmarkSpec(path string) (*BenchmarkSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec BenchmarkSpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// Validate checks that the spec is valid
+func (s *BenchmarkSpec) Validate() error {
+	if s.Config.RunsPerTest < 1 {
+		return fmt.Errorf("trials_per_task must be at least 1, got %d", s.Config.RunsPerTest)
+	}
+	if s.Config.TimeoutSec < 1 {
+		return fmt.Errorf("timeout_seconds must be at least 1, got %d", s.Config.TimeoutSec)
+	}
+	return nil
+}
+
+// ResolveTestFiles expands glob patterns to actual test files
+func (s *BenchmarkSpec) ResolveTestFiles(basePath string) ([]string, error) {
+	var files []string
+	for _, pattern := range s.Tasks {
+		fullPattern := filepath.Join(basePath, pattern)
+		matches, err := filepath.Glob(fullPattern)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	return files, nil
+}
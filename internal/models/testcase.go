@@ -0,0 +1,59 @@
+package models
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestCase represents a single evaluation test
+type TestCase struct {
+	TestID      string            `yaml:"id"`
+	DisplayName string            `yaml:"name"`
+	Summary     string            `yaml:"description,omitempty"`
+	Stimulus    TestStimulus      `yaml:"inputs"`
+	Validators  []ValidatorInline `yaml:"graders,omitempty"`
+	Active      *bool             `yaml:"enabled,omitempty"`
+	TimeoutSec  *int              `yaml:"timeout_seconds,omitempty"`
+	ContextRoot string            `yaml:"context_dir,omitempty"`
+}
+
+// TestStimulus defines the input for a test
+type TestStimulus struct {
+	Message   string         `yaml:"prompt"`
+	Metadata  map[string]any `yaml:"context,omitempty"`
+	Resources []ResourceRef  `yaml:"files,omitempty"`
+}
+
+// ResourceRef points to a file or inline content
+type ResourceRef struct {
+	Location string `yaml:"path,omitempty"`
+	Body     string `yaml:"content,omitempty"`
+}
+
+// ValidatorInline is a grader embedded in a test case
+type ValidatorInline struct {
+	Identifier string         `yaml:"name"`
+	Kind       string         `yaml:"type,omitempty"`
+	Checks     []string       `yaml:"assertions,omitempty"`
+	Parameters map[string]any `yaml:"config,omitempty"`
+}
+
+// LoadTestCase loads a test case from YAML
+func LoadTestCase(path string) (*TestCase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tc TestCase
+	if err := yaml.Unmarshal(data, &tc); err != nil {
+		return nil, err
+	}
+
+	// Active defaults to nil when not specified in YAML; the runner treats
+	// nil as true (enabled by default) and only an explicit "enabled: false"
+	// disables a test.
+
+	return &tc, nil
+}
@@ -0,0 +1,101 @@
+package orchestration
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/spboyer/waza/internal/execution"
+	"github.com/spboyer/waza/internal/models"
+)
+
+// jsonlRecord is the on-disk shape of a single JSONLProgressSink line. Kind
+// identifies which ProgressSink method produced it; only the field(s)
+// relevant to that kind are populated.
+type jsonlRecord struct {
+	Kind       string                  `json:"kind"`
+	TestID     string                  `json:"test_id,omitempty"`
+	TestName   string                  `json:"test_name,omitempty"`
+	TestNum    int                     `json:"test_num,omitempty"`
+	TotalTests int                     `json:"total_tests,omitempty"`
+	RunNum     int                     `json:"run_num,omitempty"`
+	Event      *execution.SessionEvent `json:"event,omitempty"`
+	Validation *models.GraderResults   `json:"validation,omitempty"`
+	Outcome    *models.TestOutcome     `json:"outcome,omitempty"`
+}
+
+// JSONLProgressSink writes one JSON object per line for every ProgressSink
+// callback, so an external tool (a CI dashboard, a log shipper) can tail the
+// file and reconstruct a run's timeline without depending on waza's
+// in-process types.
+type JSONLProgressSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewJSONLProgressSink writes newline-delimited JSON records to out.
+func NewJSONLProgressSink(out io.Writer) *JSONLProgressSink {
+	return &JSONLProgressSink{enc: json.NewEncoder(out)}
+}
+
+// NewJSONLProgressSinkFile opens (creating or truncating) path and returns a
+// sink that writes to it; the caller is responsible for closing the
+// returned *os.File once the benchmark run finishes.
+func NewJSONLProgressSinkFile(path string) (*JSONLProgressSink, *os.File, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create stream file %s: %w", path, err)
+	}
+	return NewJSONLProgressSink(f), f, nil
+}
+
+func (s *JSONLProgressSink) write(rec jsonlRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.enc.Encode(rec)
+}
+
+// OnTestStart writes a "test_start" record.
+func (s *JSONLProgressSink) OnTestStart(tc *models.TestCase, testNum, totalTests int) {
+	s.write(jsonlRecord{
+		Kind:       "test_start",
+		TestID:     tc.TestID,
+		TestName:   tc.DisplayName,
+		TestNum:    testNum,
+		TotalTests: totalTests,
+	})
+}
+
+// OnRunEvent writes a "run_event" record carrying the raw SessionEvent.
+func (s *JSONLProgressSink) OnRunEvent(tc *models.TestCase, runNum int, evt execution.SessionEvent) {
+	s.write(jsonlRecord{
+		Kind:   "run_event",
+		TestID: tc.TestID,
+		RunNum: runNum,
+		Event:  &evt,
+	})
+}
+
+// OnValidation writes a "validation" record carrying the grader's result.
+func (s *JSONLProgressSink) OnValidation(tc *models.TestCase, runNum int, result models.GraderResults) {
+	s.write(jsonlRecord{
+		Kind:       "validation",
+		TestID:     tc.TestID,
+		RunNum:     runNum,
+		Validation: &result,
+	})
+}
+
+// OnTestComplete writes a "test_complete" record carrying the finished
+// TestOutcome.
+func (s *JSONLProgressSink) OnTestComplete(outcome models.TestOutcome) {
+	s.write(jsonlRecord{
+		Kind:    "test_complete",
+		TestID:  outcome.TestID,
+		Outcome: &outcome,
+	})
+}
+
+var _ ProgressSink = (*JSONLProgressSink)(nil)
@@ -0,0 +1,35 @@
+package orchestration
+
+import (
+	"github.com/spboyer/waza/internal/execution"
+	"github.com/spboyer/waza/internal/models"
+)
+
+// ProgressSink receives fine-grained, live updates as a benchmark runs. It
+// sits below ProgressListener: where a ProgressListener sees benchmark-level
+// milestones, a ProgressSink also sees every SessionEvent and grader result
+// as they happen, which is what a renderer needs to draw a live view of a
+// run instead of just logging start/stop messages.
+type ProgressSink interface {
+	// OnTestStart fires once per test case, before any of its runs begin.
+	OnTestStart(tc *models.TestCase, testNum, totalTests int)
+
+	// OnRunEvent fires for every SessionEvent an engine produces while
+	// executing tc's runNum'th run.
+	OnRunEvent(tc *models.TestCase, runNum int, evt execution.SessionEvent)
+
+	// OnValidation fires once a grader has produced a result for tc's
+	// runNum'th run.
+	OnValidation(tc *models.TestCase, runNum int, result models.GraderResults)
+
+	// OnTestComplete fires once every run of tc has finished and its
+	// outcome has been computed.
+	OnTestComplete(outcome models.TestOutcome)
+}
+
+// UseProgressSink attaches sink to the runner so it receives live updates
+// during RunBenchmark. Unlike OnProgress, only one sink can be active at a
+// time since it's meant for a single live renderer or stream writer.
+func (r *TestRunner) UseProgressSink(sink ProgressSink) {
+	r.sink = sink
+}
@@ -19,11 +19,13 @@ import (
 type TestRunner struct {
 	cfg     *config.BenchmarkConfig
 	engine  execution.AgentEngine
+	pool    *execution.EnginePool
 	verbose bool
 
 	// Progress tracking
 	progressMu sync.Mutex
 	listeners  []ProgressListener
+	sink       ProgressSink
 }
 
 // ProgressListener receives progress updates
@@ -97,6 +99,26 @@ func (r *TestRunner) RunBenchmark(ctx context.Context) (*models.EvaluationOutcom
 			fmt.Printf("warning: failed to shutdown engine: %v\n", err)
 		}
 	}()
+	defer graders.ShutdownRuntimes(ctx)
+
+	spec := r.cfg.Spec()
+
+	// When the engine is a CopilotEngine and the benchmark is configured for
+	// concurrent + parallel execution, fan tests out across a pool of
+	// pre-warmed engines instead of serializing everything behind the one
+	// engine's mutex.
+	if copilotEngine, ok := r.engine.(*execution.CopilotEngine); ok && spec.Config.Concurrent && r.cfg.MaxParallel() > 1 {
+		pool, err := execution.NewEnginePool(ctx, r.cfg.MaxParallel(), copilotEngine.ModelID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create engine pool: %w", err)
+		}
+		r.pool = pool
+		defer func() {
+			if err := r.pool.Shutdown(ctx); err != nil {
+				fmt.Printf("warning: failed to shutdown engine pool: %v\n", err)
+			}
+		}()
+	}
 
 	// Load test cases
 	testCases, err := r.loadTestCases()
@@ -116,7 +138,6 @@ func (r *TestRunner) RunBenchmark(ctx context.Context) (*models.EvaluationOutcom
 	// Execute tests
 	var testOutcomes []models.TestOutcome
 
-	spec := r.cfg.Spec()
 	// Now that CopilotEngine is concurrency-safe (protected by mutex),
 	// we can safely use concurrent execution when configured
 	if spec.Config.Concurrent {
@@ -202,6 +223,9 @@ func (r *TestRunner) runSequential(ctx context.Context, testCases []*models.Test
 			TestNum:    i + 1,
 			TotalTests: len(testCases),
 		})
+		if r.sink != nil {
+			r.sink.OnTestStart(tc, i+1, len(testCases))
+		}
 
 		outcome := r.runTest(ctx, tc, i+1, len(testCases))
 		outcomes = append(outcomes, outcome)
@@ -250,6 +274,9 @@ func (r *TestRunner) runConcurrent(ctx context.Context, testCases []*models.Test
 				TestNum:    idx + 1,
 				TotalTests: len(testCases),
 			})
+			if r.sink != nil {
+				r.sink.OnTestStart(test, idx+1, len(testCases))
+			}
 
 			outcome := r.runTest(ctx, test, idx+1, len(testCases))
 			resultChan <- result{index: idx, outcome: outcome}
@@ -321,13 +348,19 @@ func (r *TestRunner) runTest(ctx context.Context, tc *models.TestCase, testNum,
 		}
 	}
 
-	return models.TestOutcome{
+	outcome := models.TestOutcome{
 		TestID:      tc.TestID,
 		DisplayName: tc.DisplayName,
 		Status:      status,
 		Runs:        runs,
 		Stats:       stats,
 	}
+
+	if r.sink != nil {
+		r.sink.OnTestComplete(outcome)
+	}
+
+	return outcome
 }
 
 func (r *TestRunner) executeRun(ctx context.Context, tc *models.TestCase, runNum int) models.RunResult {
@@ -335,9 +368,20 @@ func (r *TestRunner) executeRun(ctx context.Context, tc *models.TestCase, runNum
 
 	// Prepare execution request
 	req := r.buildExecutionRequest(tc)
+	if r.sink != nil {
+		req.OnEvent = func(evt execution.SessionEvent) {
+			r.sink.OnRunEvent(tc, runNum, evt)
+		}
+	}
 
-	// Execute
-	resp, err := r.engine.Execute(ctx, req)
+	// Execute, preferring the engine pool when one was set up for this run
+	var resp *execution.ExecutionResponse
+	var err error
+	if r.pool != nil {
+		resp, err = r.pool.Execute(ctx, req)
+	} else {
+		resp, err = r.engine.Execute(ctx, req)
+	}
 	if err != nil {
 		return models.RunResult{
 			RunNumber:  runNum,
@@ -361,6 +405,12 @@ func (r *TestRunner) executeRun(ctx context.Context, tc *models.TestCase, runNum
 		}
 	}
 
+	if r.sink != nil {
+		for _, result := range gradersResults {
+			r.sink.OnValidation(tc, runNum, result)
+		}
+	}
+
 	// Determine status
 	status := "passed"
 	if resp.ErrorMsg != "" {
@@ -492,6 +542,7 @@ func (r *TestRunner) buildGraderContext(tc *models.TestCase, resp *execution.Exe
 		Outcome:    make(map[string]any),
 		DurationMS: resp.DurationMs,
 		Metadata:   make(map[string]any),
+		ToolCalls:  resp.ToolCalls,
 	}
 }
 
@@ -501,7 +552,7 @@ func (r *TestRunner) runGraders(ctx context.Context, tc *models.TestCase, grader
 	// Run global validators
 	spec := r.cfg.Spec()
 	for _, vCfg := range spec.Graders {
-		grader, err := graders.Create(graders.Type(vCfg.Kind), vCfg.Identifier, vCfg.Parameters)
+		grader, err := graders.Create(graders.Type(vCfg.Kind), vCfg.Identifier, vCfg.Parameters, r.engine)
 
 		if err != nil {
 			return nil, err
@@ -531,7 +582,7 @@ func (r *TestRunner) runGraders(ctx context.Context, tc *models.TestCase, grader
 			params["assertions"] = vCfg.Checks
 		}
 
-		grader, err := graders.Create(graders.Type(kind), vCfg.Identifier, params)
+		grader, err := graders.Create(graders.Type(kind), vCfg.Identifier, params, r.engine)
 
 		if err != nil {
 			return nil, fmt.Errorf("failed to create grader %s: %w", vCfg.Identifier, err)
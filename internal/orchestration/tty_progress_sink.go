@@ -0,0 +1,130 @@
+package orchestration
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/spboyer/waza/internal/execution"
+	"github.com/spboyer/waza/internal/models"
+)
+
+var spinnerFrames = []rune{'|', '/', '-', '\\'}
+
+// runLiveState tracks the counters a TTYProgressSink renders while a single
+// run is still in flight.
+type runLiveState struct {
+	events    int
+	toolCalls int
+	tokensIn  int
+	tokensOut int
+	spinner   int
+}
+
+// TTYProgressSink renders a live tree of tests -> runs as a benchmark
+// executes: one line per run that's updated in place with an event count, a
+// running tool-call tally, and a spinner, followed by a pass/fail line per
+// grader once the run is graded and a status line once the test completes. When out isn't a terminal it falls back to plain,
+// append-only log lines so output stays readable when piped to a file or CI
+// log collector.
+type TTYProgressSink struct {
+	out        io.Writer
+	isTerminal bool
+
+	mu    sync.Mutex
+	state map[string]*runLiveState
+}
+
+// NewTTYProgressSink builds a sink that writes to out, auto-detecting
+// whether out is a terminal (an *os.File is checked for being a character
+// device; any other writer is treated as non-interactive).
+func NewTTYProgressSink(out io.Writer) *TTYProgressSink {
+	return &TTYProgressSink{
+		out:        out,
+		isTerminal: isTerminalWriter(out),
+		state:      make(map[string]*runLiveState),
+	}
+}
+
+func isTerminalWriter(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func runKey(testID string, runNum int) string {
+	return fmt.Sprintf("%s#%d", testID, runNum)
+}
+
+// OnTestStart prints a header line for the test about to run.
+func (s *TTYProgressSink) OnTestStart(tc *models.TestCase, testNum, totalTests int) {
+	fmt.Fprintf(s.out, "[%d/%d] %s\n", testNum, totalTests, tc.DisplayName)
+}
+
+// OnRunEvent updates the in-flight counters for a run and, on a terminal,
+// redraws its status line.
+func (s *TTYProgressSink) OnRunEvent(tc *models.TestCase, runNum int, evt execution.SessionEvent) {
+	key := runKey(tc.TestID, runNum)
+
+	s.mu.Lock()
+	st, ok := s.state[key]
+	if !ok {
+		st = &runLiveState{}
+		s.state[key] = st
+	}
+	st.events++
+	st.spinner = (st.spinner + 1) % len(spinnerFrames)
+	if evt.EventType == "tool.execution_start" {
+		st.toolCalls++
+	}
+	if in, ok := evt.Payload["tokens_in"].(int); ok {
+		st.tokensIn = in
+	}
+	if out, ok := evt.Payload["tokens_out"].(int); ok {
+		st.tokensOut = out
+	}
+	frame := spinnerFrames[st.spinner]
+	events, toolCalls, tokensIn, tokensOut := st.events, st.toolCalls, st.tokensIn, st.tokensOut
+	s.mu.Unlock()
+
+	if !s.isTerminal {
+		return
+	}
+
+	fmt.Fprintf(s.out, "\r  %c run %d: %d events, %d tool calls, tokens in/out %d/%d   ",
+		frame, runNum, events, toolCalls, tokensIn, tokensOut)
+}
+
+// OnValidation reports a single grader's result once it's available.
+func (s *TTYProgressSink) OnValidation(tc *models.TestCase, runNum int, result models.GraderResults) {
+	status := "pass"
+	if !result.Passed {
+		status = "fail"
+	}
+
+	if s.isTerminal {
+		fmt.Fprintln(s.out)
+	}
+	fmt.Fprintf(s.out, "    %s: %s\n", result.Name, status)
+}
+
+// OnTestComplete prints the overall status of a test once all its runs have
+// finished and drops the in-flight counters for its runs.
+func (s *TTYProgressSink) OnTestComplete(outcome models.TestOutcome) {
+	s.mu.Lock()
+	for _, run := range outcome.Runs {
+		delete(s.state, runKey(outcome.TestID, run.RunNumber))
+	}
+	s.mu.Unlock()
+
+	fmt.Fprintf(s.out, "  -> %s: %s\n", outcome.DisplayName, outcome.Status)
+}
+
+var _ ProgressSink = (*TTYProgressSink)(nil)
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spboyer/waza/waza-go/internal/config"
+	"github.com/spboyer/waza/waza-go/internal/models"
+	"github.com/spboyer/waza/waza-go/internal/orchestration"
+	"github.com/spboyer/waza/waza-go/internal/scoring"
+	"github.com/spf13/cobra"
+)
+
+func newExplainCommand() *cobra.Command {
+	var testID string
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "explain <spec.yaml>",
+		Short: "Run one test and show why each grader passed or failed",
+		Long: `Explain runs a single test's stimulus through the engine once, the same
+way "waza run" would, but instead of a pass/fail verdict per grader it
+prints the individual assertion or pattern each grader checked and
+whether that specific check passed. It's meant for the moment a grader's
+overall verdict doesn't make sense and you need to see which assertion
+is actually responsible, without adding print statements to the spec.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return explainCommandE(args[0], testID, format)
+		},
+	}
+
+	cmd.Flags().StringVar(&testID, "test", "", "ID of the test to explain (required)")
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text or json")
+	_ = cmd.MarkFlagRequired("test")
+
+	return cmd
+}
+
+func explainCommandE(specPath, testID, format string) error {
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid --format %q, expected \"text\" or \"json\"", format)
+	}
+
+	spec, err := models.LoadBenchmarkSpec(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to load spec: %w", err)
+	}
+
+	specDir := filepath.Dir(specPath)
+	if !filepath.IsAbs(specDir) {
+		if abs, err := filepath.Abs(specDir); err == nil {
+			specDir = abs
+		}
+	}
+	fixtureDir := filepath.Join(specDir, "fixtures")
+
+	cfg := config.NewBenchmarkConfig(spec,
+		config.WithSpecDir(specDir),
+		config.WithFixtureDir(fixtureDir),
+	)
+
+	engine, err := newAgentEngine(spec.Config)
+	if err != nil {
+		return err
+	}
+
+	runner := orchestration.NewTestRunner(cfg, engine)
+	traces, err := runner.ExplainTest(context.Background(), testID)
+	if err != nil {
+		return fmt.Errorf("explain failed: %w", err)
+	}
+
+	if format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(traces)
+	}
+
+	printExplainTree(testID, traces)
+	return nil
+}
+
+// printExplainTree renders traces as an indented, cscli-explain-style tree:
+// one line per grader, then one indented line per step it checked.
+func printExplainTree(testID string, traces []*scoring.ValidationTrace) {
+	fmt.Printf("=== %s ===\n", testID)
+	for _, trace := range traces {
+		fmt.Printf("%s %s (%s, %dms)\n", verdictMark(trace.Passed), trace.Identifier, trace.Kind, trace.DurationMs)
+		for _, step := range trace.Steps {
+			fmt.Printf("    %s %s\n", verdictMark(step.Passed), step.Description)
+			if msg, ok := step.Details["error"]; ok {
+				fmt.Printf("        error: %v\n", msg)
+			}
+		}
+	}
+}
+
+func verdictMark(passed bool) string {
+	if passed {
+		return "PASS"
+	}
+	return "FAIL"
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spboyer/waza/waza-go/internal/config"
+	"github.com/spboyer/waza/waza-go/internal/execution"
+	"github.com/spboyer/waza/waza-go/internal/filter"
+	"github.com/spboyer/waza/waza-go/internal/models"
+	"github.com/spboyer/waza/waza-go/internal/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listFormat    string
+	listFocus     string
+	listSkip      string
+	listLabels    string
+	listRun       string
+	listRunSkip   string
+	listTag       string
+	listSeed      int64
+	listRandomize bool
+	listShard     string
+)
+
+func newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <spec.yaml>",
+		Short: "Resolve and print the test plan a run would execute, without running it",
+		Long: `List resolves a spec's test file globs, loads every test case, and applies
+the same --focus/--skip/--labels and --randomize/--shard selection "run" would,
+then prints the resulting plan — test IDs, labels, expected run count, and
+timeouts — instead of executing anything.
+
+This lets the plan be piped into shard planners, cost estimators, or a
+dashboard without spending any agent runs.`,
+		Args: cobra.ExactArgs(1),
+		RunE: listCommandE,
+	}
+
+	cmd.Flags().StringVar(&listFormat, "format", "text", "Output format: text or json")
+	cmd.Flags().StringVar(&listFocus, "focus", "", "Only list tests whose ID or name matches this regular expression (falls back to WAZA_FOCUS)")
+	cmd.Flags().StringVar(&listSkip, "skip", "", "List but mark as skipped any test whose ID or name matches this regular expression")
+	cmd.Flags().StringVar(&listLabels, "labels", "", "Only list tests whose tags satisfy this boolean expression, e.g. \"smoke && !slow\" (falls back to WAZA_LABELS)")
+	cmd.Flags().StringVar(&listRun, "run", "", "Only list tests whose slash-separated ID matches this go-test-style selector, e.g. \"auth/.*login.*\"")
+	cmd.Flags().StringVar(&listRunSkip, "run-skip", "", "List but mark as skipped any test whose slash-separated ID matches this go-test-style selector")
+	cmd.Flags().StringVar(&listTag, "tag", "", "Only list tests with at least one label in this comma-separated selector, e.g. \"@slow,@integration\"")
+	cmd.Flags().Int64Var(&listSeed, "seed", 0, "Seed for --randomize, so the listed order matches the run it previews")
+	cmd.Flags().BoolVar(&listRandomize, "randomize", false, "Preview the shuffled test order --randomize would run")
+	cmd.Flags().StringVar(&listShard, "shard", "", "Preview only shard i of N, e.g. \"2/4\"")
+
+	return cmd
+}
+
+func listCommandE(cmd *cobra.Command, args []string) error {
+	specPath := args[0]
+
+	if listFormat != "text" && listFormat != "json" {
+		return fmt.Errorf("invalid --format %q, expected \"text\" or \"json\"", listFormat)
+	}
+
+	spec, err := models.LoadBenchmarkSpec(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to load spec: %w", err)
+	}
+
+	specDir := filepath.Dir(specPath)
+	if !filepath.IsAbs(specDir) {
+		if abs, err := filepath.Abs(specDir); err == nil {
+			specDir = abs
+		}
+	}
+
+	shardIndex, shardTotal, err := parseShard(listShard)
+	if err != nil {
+		return err
+	}
+
+	cfg := config.NewBenchmarkConfig(spec,
+		config.WithSpecDir(specDir),
+		config.WithFilter(filter.Opts{Focus: listFocus, Skip: listSkip, Labels: listLabels, Run: listRun, RunSkip: listRunSkip, Tags: listTag}),
+		config.WithRunPlan(config.RunPlan{
+			Seed:       listSeed,
+			Randomize:  listRandomize,
+			ShardIndex: shardIndex,
+			ShardTotal: shardTotal,
+		}),
+	)
+
+	// Listing never executes a test, so a mock engine stands in: TestRunner
+	// requires one, but Plan never calls it.
+	runner := orchestration.NewTestRunner(cfg, execution.NewMockEngine(""))
+
+	plan, err := runner.Plan()
+	if err != nil {
+		return fmt.Errorf("failed to resolve test plan: %w", err)
+	}
+
+	if listFormat == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(plan)
+	}
+
+	printPlan(plan)
+	return nil
+}
+
+func printPlan(plan *orchestration.Plan) {
+	fmt.Printf("Plan for: %s\n\n", plan.SpecName)
+
+	for _, t := range plan.Tests {
+		if t.Skipped {
+			fmt.Printf("- %s (%s): SKIPPED (%s)\n", t.TestID, t.DisplayName, t.SkippedReason)
+			continue
+		}
+
+		labels := ""
+		if len(t.Labels) > 0 {
+			labels = " [" + strings.Join(t.Labels, ", ") + "]"
+		}
+		fmt.Printf("- %s (%s)%s: %d run(s), %ds timeout\n", t.TestID, t.DisplayName, labels, t.Runs, t.TimeoutSec)
+		if len(t.Graders) > 0 {
+			fmt.Printf("    graders: %s\n", strings.Join(t.Graders, ", "))
+		}
+		if len(t.ResolvedFixtures) > 0 {
+			fmt.Printf("    fixtures: %s\n", strings.Join(t.ResolvedFixtures, ", "))
+		}
+	}
+
+	fmt.Println()
+	fmt.Printf("%d test(s), %d total run(s)\n", len(plan.Tests), plan.TotalRuns)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spboyer/waza/waza-go/internal/config"
+	"github.com/spboyer/waza/waza-go/internal/loadtest"
+	"github.com/spboyer/waza/waza-go/internal/models"
+	"github.com/spboyer/waza/waza-go/internal/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var loadtestPlanPath string
+
+func newLoadTestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "loadtest <spec.yaml> --plan <plan.yaml>",
+		Short: "Stress-test an engine under a weighted, rate-limited workload",
+		Long: `Loadtest drives the configured engine with a "run plan": a sequence of
+named strategies, each spawning a fixed number of virtual users that
+repeatedly pick a weighted-random test case and execute it, capped by a
+token-bucket rate limiter, for a fixed duration or iteration count.
+
+Per-strategy metrics (p50/p95/p99 latency, throughput, error rate, validator
+pass rate, tool-calls/sec) are printed as each strategy finishes.`,
+		Args: cobra.ExactArgs(1),
+		RunE: loadTestCommandE,
+	}
+
+	cmd.Flags().StringVar(&loadtestPlanPath, "plan", "", "Path to the load test plan (YAML)")
+	cmd.MarkFlagRequired("plan")
+
+	return cmd
+}
+
+func loadTestCommandE(cmd *cobra.Command, args []string) error {
+	specPath := args[0]
+
+	spec, err := models.LoadBenchmarkSpec(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to load spec: %w", err)
+	}
+
+	plan, err := loadtest.LoadPlan(loadtestPlanPath)
+	if err != nil {
+		return fmt.Errorf("failed to load load test plan: %w", err)
+	}
+
+	specDir := filepath.Dir(specPath)
+	if !filepath.IsAbs(specDir) {
+		if abs, err := filepath.Abs(specDir); err == nil {
+			specDir = abs
+		}
+	}
+
+	cfg := config.NewBenchmarkConfig(spec, config.WithSpecDir(specDir))
+
+	engine, err := newAgentEngine(spec.Config)
+	if err != nil {
+		return err
+	}
+
+	runner := orchestration.NewTestRunner(cfg, engine)
+	runner.OnProgress(loadTestProgressListener)
+
+	report, err := runner.RunLoadTest(context.Background(), plan)
+	if err != nil {
+		return fmt.Errorf("load test failed: %w", err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+
+	return nil
+}
+
+func loadTestProgressListener(event orchestration.ProgressEvent) {
+	switch event.EventType {
+	case orchestration.EventLoadTestTick:
+		fmt.Printf("[%s] %v request(s) so far (%vms elapsed)\n", event.TestName, event.Details["requests_so_far"], event.Details["elapsed_ms"])
+	case orchestration.EventStrategyComplete:
+		fmt.Printf("[%s] strategy complete\n", event.TestName)
+	}
+}
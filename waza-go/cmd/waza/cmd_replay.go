@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spboyer/waza/waza-go/internal/config"
+	"github.com/spboyer/waza/waza-go/internal/filter"
+	"github.com/spboyer/waza/waza-go/internal/models"
+	"github.com/spboyer/waza/waza-go/internal/orchestration"
+	"github.com/spf13/cobra"
+)
+
+func newReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay <spec.yaml> <outcome.json>",
+		Short: "Re-run a recorded outcome's failing tests with their exact seeds",
+		Long: `Replay loads a previous "waza run" outcome, re-runs only the tests that
+didn't pass using the deterministic seed each failing run recorded, and
+diffs the new output against the stored one. This lets a flaky or broken
+test be investigated without re-running the whole suite.`,
+		Args: cobra.ExactArgs(2),
+		RunE: replayCommandE,
+	}
+
+	return cmd
+}
+
+func replayCommandE(cmd *cobra.Command, args []string) error {
+	specPath, outcomePath := args[0], args[1]
+
+	spec, err := models.LoadBenchmarkSpec(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to load spec: %w", err)
+	}
+
+	data, err := os.ReadFile(outcomePath)
+	if err != nil {
+		return fmt.Errorf("failed to read outcome: %w", err)
+	}
+
+	var recorded models.EvaluationOutcome
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return fmt.Errorf("failed to parse outcome: %w", err)
+	}
+
+	failing := make(map[string]models.TestOutcome)
+	for _, to := range recorded.TestOutcomes {
+		if to.Status != "passed" {
+			failing[to.TestID] = to
+		}
+	}
+	if len(failing) == 0 {
+		fmt.Println("No failing tests recorded in outcome; nothing to replay.")
+		return nil
+	}
+
+	ids := make([]string, 0, len(failing))
+	for id := range failing {
+		ids = append(ids, regexp.QuoteMeta(id))
+	}
+
+	specDir := filepath.Dir(specPath)
+	if !filepath.IsAbs(specDir) {
+		if abs, err := filepath.Abs(specDir); err == nil {
+			specDir = abs
+		}
+	}
+	fixtureDir := filepath.Join(specDir, "fixtures")
+
+	// Replay re-runs each failing test exactly once under its recorded
+	// seed, so the spec's own trials_per_task doesn't apply here.
+	spec.Config.RunsPerTest = 1
+
+	cfg := config.NewBenchmarkConfig(spec,
+		config.WithSpecDir(specDir),
+		config.WithFixtureDir(fixtureDir),
+		config.WithFilter(filter.Opts{Focus: "^(" + strings.Join(ids, "|") + ")$"}),
+	)
+
+	engine, err := newAgentEngine(spec.Config)
+	if err != nil {
+		return err
+	}
+
+	runner := orchestration.NewTestRunner(cfg, engine)
+	for id, to := range failing {
+		if run := firstFailingRun(to); run.Seed != 0 {
+			runner.OverrideSeed(id, run.Seed)
+		}
+	}
+
+	outcome, err := runner.RunBenchmark(context.Background())
+	if err != nil {
+		return fmt.Errorf("replay failed: %w", err)
+	}
+
+	for _, to := range outcome.TestOutcomes {
+		old := firstFailingRun(failing[to.TestID])
+		var replayed models.RunResult
+		if len(to.Runs) > 0 {
+			replayed = to.Runs[0]
+		}
+
+		fmt.Printf("=== %s ===\n", to.TestID)
+		fmt.Printf("seed: %d  recorded: %s -> replay: %s\n", old.Seed, old.Status, replayed.Status)
+		fmt.Print(diffLines(old.FinalOutput, replayed.FinalOutput))
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// firstFailingRun returns the first non-passed run recorded for a test
+// outcome, falling back to its first run if every run happened to pass
+// (e.g. the outcome predates per-run seed recording).
+func firstFailingRun(to models.TestOutcome) models.RunResult {
+	for _, run := range to.Runs {
+		if run.Status != "passed" {
+			return run
+		}
+	}
+	if len(to.Runs) > 0 {
+		return to.Runs[0]
+	}
+	return models.RunResult{}
+}
+
+// diffLines returns a unified-style line diff between oldText and newText
+// via a longest-common-subsequence alignment, so a replayed run's final
+// output can be compared against the one recorded in the original outcome.
+func diffLines(oldText, newText string) string {
+	oldLines := strings.Split(oldText, "\n")
+	newLines := strings.Split(newText, "\n")
+	n, m := len(oldLines), len(newLines)
+
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var out strings.Builder
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case oldLines[i] == newLines[j]:
+			fmt.Fprintf(&out, "  %s\n", oldLines[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			fmt.Fprintf(&out, "- %s\n", oldLines[i])
+			i++
+		default:
+			fmt.Fprintf(&out, "+ %s\n", newLines[j])
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		fmt.Fprintf(&out, "- %s\n", oldLines[i])
+	}
+	for ; j < m; j++ {
+		fmt.Fprintf(&out, "+ %s\n", newLines[j])
+	}
+
+	return out.String()
+}
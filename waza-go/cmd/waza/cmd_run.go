@@ -11,15 +11,33 @@ import (
 
 	"github.com/spboyer/waza/waza-go/internal/config"
 	"github.com/spboyer/waza/waza-go/internal/execution"
+	"github.com/spboyer/waza/waza-go/internal/filter"
 	"github.com/spboyer/waza/waza-go/internal/models"
 	"github.com/spboyer/waza/waza-go/internal/orchestration"
+	"github.com/spboyer/waza/waza-go/internal/reporting"
 	"github.com/spf13/cobra"
 )
 
 var (
-	contextDir string
-	outputPath string
-	verbose    bool
+	contextDir  string
+	outputPath  string
+	verbose     bool
+	reportSpecs []string
+	focusFlag   string
+	skipFlag    string
+	labelsFlag  string
+	runFlag     string
+	runSkipFlag string
+	tagFlag     string
+	seedFlag    int64
+	randomize   bool
+	shardFlag   string
+	repeatFlag  int
+	untilFail   bool
+	maxDuration time.Duration
+	streamJSONL string
+	dryRun      bool
+	varFlags    []string
 )
 
 func newRunCommand() *cobra.Command {
@@ -37,6 +55,22 @@ Resources are loaded from the context directory (defaults to ./fixtures).`,
 	cmd.Flags().StringVar(&contextDir, "context-dir", "", "Context directory for fixtures (default: ./fixtures relative to spec)")
 	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output JSON file for results")
 	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Verbose output with detailed progress")
+	cmd.Flags().StringArrayVar(&reportSpecs, "report", nil, "Write an additional report as <format>=<path> (json, jsonl, junit, tap, markdown); repeatable")
+	cmd.Flags().StringVar(&focusFlag, "focus", "", "Only run tests whose ID or name matches this regular expression (falls back to WAZA_FOCUS)")
+	cmd.Flags().StringVar(&skipFlag, "skip", "", "Skip tests whose ID or name matches this regular expression")
+	cmd.Flags().StringVar(&labelsFlag, "labels", "", "Only run tests whose tags satisfy this boolean expression, e.g. \"smoke && !slow\" (falls back to WAZA_LABELS)")
+	cmd.Flags().StringVar(&runFlag, "run", "", "Only run tests whose slash-separated ID matches this go-test-style selector, e.g. \"auth/.*login.*\"")
+	cmd.Flags().StringVar(&runSkipFlag, "run-skip", "", "Skip tests whose slash-separated ID matches this go-test-style selector, taking precedence over --run")
+	cmd.Flags().StringVar(&tagFlag, "tag", "", "Only run tests with at least one label in this comma-separated selector, e.g. \"@slow,@integration\"")
+	cmd.Flags().Int64Var(&seedFlag, "seed", 0, "Seed for --randomize (recorded on the outcome so a flaky run can be reproduced; 0 uses the spec's config.seed, or a random seed if that is unset too)")
+	cmd.Flags().BoolVar(&randomize, "randomize", false, "Shuffle the resolved test list deterministically before running")
+	cmd.Flags().StringVar(&shardFlag, "shard", "", "Run only shard i of N, e.g. \"2/4\" (shards split the, possibly shuffled, test list evenly)")
+	cmd.Flags().IntVar(&repeatFlag, "repeat", 1, "Rerun the whole benchmark this many times, aggregating a combined outcome")
+	cmd.Flags().BoolVar(&untilFail, "until-fail", false, "Ignore --repeat and rerun the benchmark until a test fails or --max-duration is hit")
+	cmd.Flags().DurationVar(&maxDuration, "max-duration", 0, "Upper bound on how long --until-fail may loop (0 means no bound)")
+	cmd.Flags().StringVar(&streamJSONL, "stream-jsonl", "", "Append each test's result to this JSONL file as it completes, so a crash mid-run still leaves partial results on disk")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Resolve and print the fully-expanded plan (tests, graders, fixtures, timeouts) without calling the engine")
+	cmd.Flags().StringArrayVar(&varFlags, "var", nil, "Set a variable as key=value for {{.key}} interpolation in prompts, resource bodies and grader parameters; repeatable")
 
 	return cmd
 }
@@ -72,28 +106,70 @@ func runCommandE(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	shardIndex, shardTotal, err := parseShard(shardFlag)
+	if err != nil {
+		return err
+	}
+
+	vars, err := parseVars(varFlags)
+	if err != nil {
+		return err
+	}
+
 	// Create config with both directories
 	cfg := config.NewBenchmarkConfig(spec,
 		config.WithSpecDir(specDir),       // For resolving test file patterns
 		config.WithFixtureDir(fixtureDir), // For loading resource files
 		config.WithVerbose(verbose),
 		config.WithOutputPath(outputPath),
+		config.WithFilter(filter.Opts{Focus: focusFlag, Skip: skipFlag, Labels: labelsFlag, Run: runFlag, RunSkip: runSkipFlag, Tags: tagFlag}),
+		config.WithVars(vars),
+		config.WithRunPlan(config.RunPlan{
+			Seed:        seedFlag,
+			Randomize:   randomize,
+			ShardIndex:  shardIndex,
+			ShardTotal:  shardTotal,
+			Repeat:      repeatFlag,
+			UntilFail:   untilFail,
+			MaxDuration: maxDuration,
+		}),
 	)
 
-	// Create engine based on spec
-	var engine execution.AgentEngine
+	if dryRun {
+		// Dry-run never executes a test, so a mock engine stands in: Plan
+		// never calls it, same as `waza list`.
+		runner := orchestration.NewTestRunner(cfg, execution.NewMockEngine(""))
+		plan, err := runner.Plan()
+		if err != nil {
+			return fmt.Errorf("failed to resolve test plan: %w", err)
+		}
+		printPlan(plan)
+		return nil
+	}
 
-	switch spec.RuntimeOptions.EngineType {
-	case "mock":
-		engine = execution.NewMockEngine(spec.RuntimeOptions.ModelID)
-	case "copilot-sdk":
-		engine = execution.NewCopilotEngineBuilder(spec.RuntimeOptions.ModelID).Build()
-	default:
-		return fmt.Errorf("unknown engine type: %s", spec.RuntimeOptions.EngineType)
+	// Resolve the execution backend: a registered non-LLM Adapter (gtest,
+	// pytest, exec, ...) takes priority, falling back to an AgentEngine.
+	var engine execution.AgentEngine
+	adapter, isAdapter := execution.CreateAdapter(spec.Config.EngineType, execution.AdapterConfig{
+		ModelID:    spec.Config.ModelID,
+		TimeoutSec: spec.Config.TimeoutSec,
+		Command:    spec.Config.AdapterCommand,
+		Args:       spec.Config.AdapterArgs,
+	})
+
+	if !isAdapter {
+		var err error
+		engine, err = newAgentEngine(spec.Config)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Create runner
 	runner := orchestration.NewTestRunner(cfg, engine)
+	if isAdapter {
+		runner.UseAdapter(adapter)
+	}
 
 	// Add progress listener
 	if verbose {
@@ -102,13 +178,27 @@ func runCommandE(cmd *cobra.Command, args []string) error {
 		runner.OnProgress(simpleProgressListener)
 	}
 
+	if streamJSONL != "" {
+		streamFile, err := os.Create(streamJSONL)
+		if err != nil {
+			return fmt.Errorf("failed to create --stream-jsonl file: %w", err)
+		}
+		defer streamFile.Close()
+
+		runner.OnTestOutcome(func(outcome models.TestOutcome) {
+			if err := streamOutcomeJSONL(streamFile, outcome); err != nil {
+				fmt.Fprintf(os.Stderr, "warning: failed to stream result for %s: %v\n", outcome.DisplayName, err)
+			}
+		})
+	}
+
 	// Run benchmark
 	ctx := context.Background()
 
 	fmt.Printf("Running benchmark: %s\n", spec.Name)
 	fmt.Printf("Skill: %s\n", spec.SkillName)
-	fmt.Printf("Engine: %s\n", spec.RuntimeOptions.EngineType)
-	fmt.Printf("Model: %s\n", spec.RuntimeOptions.ModelID)
+	fmt.Printf("Engine: %s\n", spec.Config.EngineType)
+	fmt.Printf("Model: %s\n", spec.Config.ModelID)
 	fmt.Println()
 
 	outcome, err := runner.RunBenchmark(ctx)
@@ -127,6 +217,11 @@ func runCommandE(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\nResults saved to: %s\n", outputPath)
 	}
 
+	// Write any additional reports requested via --report <format>=<path>
+	if err := writeReports(outcome, reportSpecs); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
 	// Exit with error code if tests failed
 	if outcome.Digest.Failed > 0 || outcome.Digest.Errors > 0 {
 		return fmt.Errorf("benchmark completed with failures")
@@ -177,11 +272,22 @@ func printSummary(outcome *models.EvaluationOutcome) {
 	fmt.Printf("Succeeded:      %d\n", digest.Succeeded)
 	fmt.Printf("Failed:         %d\n", digest.Failed)
 	fmt.Printf("Errors:         %d\n", digest.Errors)
+	fmt.Printf("Skipped:        %d\n", digest.Skipped)
 	fmt.Printf("Success Rate:   %.1f%%\n", digest.SuccessRate*100)
 	fmt.Printf("Aggregate Score: %.2f\n", digest.AggregateScore)
 
 	duration := time.Duration(digest.DurationMs) * time.Millisecond
 	fmt.Printf("Duration:       %v\n", duration)
+
+	if outcome.Setup.Iterations > 1 {
+		fmt.Printf("Iterations:     %d\n", outcome.Setup.Iterations)
+	}
+	if outcome.Setup.Seed != 0 {
+		fmt.Printf("Seed:           %d\n", outcome.Setup.Seed)
+	}
+	if outcome.Setup.ShardTotal > 1 {
+		fmt.Printf("Shard:          %d/%d\n", outcome.Setup.ShardIndex, outcome.Setup.ShardTotal)
+	}
 	fmt.Println()
 
 	// Show failed tests
@@ -215,3 +321,101 @@ func saveOutcome(outcome *models.EvaluationOutcome, path string) error {
 
 	return os.WriteFile(path, data, 0644)
 }
+
+// streamOutcomeJSONL appends outcome's runs to streamFile using the same
+// reporting.JSONLWriter format as --report jsonl, flushing to disk
+// immediately so a crash mid-benchmark still leaves partial results behind.
+func streamOutcomeJSONL(streamFile *os.File, outcome models.TestOutcome) error {
+	writer := &reporting.JSONLWriter{}
+	fakeOutcome := &models.EvaluationOutcome{TestOutcomes: []models.TestOutcome{outcome}}
+
+	if err := writer.Write(fakeOutcome, streamFile); err != nil {
+		return err
+	}
+
+	return streamFile.Sync()
+}
+
+// writeReports parses each "<format>=<path>" spec from --report and writes
+// outcome to that path using the matching reporting.ReportWriter.
+func writeReports(outcome *models.EvaluationOutcome, specs []string) error {
+	for _, spec := range specs {
+		format, path, ok := strings.Cut(spec, "=")
+		if !ok {
+			return fmt.Errorf("invalid --report value %q, expected <format>=<path>", spec)
+		}
+
+		writer, err := reporting.Create(format)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create report file %s: %w", path, err)
+		}
+
+		if err := writer.Write(outcome, f); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to write %s report to %s: %w", format, path, err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to close report file %s: %w", path, err)
+		}
+
+		fmt.Printf("%s report written to: %s\n", format, path)
+	}
+
+	return nil
+}
+
+// parseShard parses a "--shard i/N" value, where i is the 1-based shard
+// number (matching the common CI-matrix convention, e.g. "4/4" for the
+// last of four shards), into a zero-based index and a total shard count.
+// An empty string disables sharding (index 0, total 0).
+func parseShard(s string) (index, total int, err error) {
+	if s == "" {
+		return 0, 0, nil
+	}
+
+	indexStr, totalStr, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid --shard value %q, expected <index>/<total>", s)
+	}
+
+	var oneBasedIndex int
+	if _, err := fmt.Sscanf(indexStr, "%d", &oneBasedIndex); err != nil {
+		return 0, 0, fmt.Errorf("invalid --shard index %q: %w", indexStr, err)
+	}
+	if _, err := fmt.Sscanf(totalStr, "%d", &total); err != nil {
+		return 0, 0, fmt.Errorf("invalid --shard total %q: %w", totalStr, err)
+	}
+
+	if total < 1 {
+		return 0, 0, fmt.Errorf("invalid --shard value %q: total must be >= 1", s)
+	}
+	if oneBasedIndex < 1 || oneBasedIndex > total {
+		return 0, 0, fmt.Errorf("invalid --shard value %q: index must be in [1, %d]", s, total)
+	}
+
+	return oneBasedIndex - 1, total, nil
+}
+
+// parseVars turns repeated "key=value" --var flags into a map for {{.var}}
+// template interpolation.
+func parseVars(flags []string) (map[string]any, error) {
+	if len(flags) == 0 {
+		return nil, nil
+	}
+
+	vars := make(map[string]any, len(flags))
+	for _, kv := range flags {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --var value %q, expected key=value", kv)
+		}
+		vars[key] = value
+	}
+
+	return vars, nil
+}
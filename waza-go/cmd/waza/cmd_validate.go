@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+	"github.com/spf13/cobra"
+)
+
+func newValidateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate <spec.yaml>",
+		Short: "Validate a benchmark spec and its test cases",
+		Long: `Validate a benchmark spec file and every test case it references
+against their JSON Schemas, printing every problem found rather than
+stopping at the first one.`,
+		Args: cobra.ExactArgs(1),
+		RunE: validateCommandE,
+	}
+}
+
+func validateCommandE(cmd *cobra.Command, args []string) error {
+	specPath := args[0]
+	specDir := filepath.Dir(specPath)
+
+	spec, err := models.LoadBenchmarkSpecStrict(specPath)
+	if err != nil {
+		fmt.Printf("%s:\n%v\n", specPath, err)
+		return fmt.Errorf("validation failed")
+	}
+
+	ok := true
+
+	testFiles, err := spec.ResolveTestFiles(specDir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve test files: %w", err)
+	}
+
+	for _, path := range testFiles {
+		if _, err := models.LoadTestCaseStrict(path); err != nil {
+			fmt.Printf("%s:\n%v\n", path, err)
+			ok = false
+		}
+	}
+
+	if !ok {
+		return fmt.Errorf("validation failed")
+	}
+
+	fmt.Printf("%s: valid (%d test case(s))\n", specPath, len(testFiles))
+	return nil
+}
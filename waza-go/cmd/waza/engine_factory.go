@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spboyer/waza/waza-go/internal/execution"
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// newAgentEngine resolves spec.Config.EngineType to a concrete AgentEngine.
+// Shared by every command that runs tests against an engine directly
+// (run, loadtest, replay, explain) so adding a new backend means touching
+// one switch instead of four.
+func newAgentEngine(cfg models.Config) (execution.AgentEngine, error) {
+	switch cfg.EngineType {
+	case "mock":
+		return execution.NewMockEngine(cfg.ModelID), nil
+	case "copilot-sdk":
+		return execution.NewCopilotEngineBuilder(cfg.ModelID).Build(), nil
+	case "openai":
+		return execution.NewOpenAIEngine(cfg.ModelID, cfg.EngineParams)
+	case "anthropic":
+		return execution.NewAnthropicEngine(cfg.ModelID, cfg.EngineParams)
+	case "ollama":
+		return execution.NewOllamaEngine(cfg.ModelID, cfg.EngineParams)
+	default:
+		return nil, fmt.Errorf("unknown engine type: %s", cfg.EngineType)
+	}
+}
@@ -20,6 +20,11 @@ performance against predefined test cases.`,
 
 	// Add subcommands
 	cmd.AddCommand(newRunCommand())
+	cmd.AddCommand(newValidateCommand())
+	cmd.AddCommand(newListCommand())
+	cmd.AddCommand(newLoadTestCommand())
+	cmd.AddCommand(newReplayCommand())
+	cmd.AddCommand(newExplainCommand())
 
 	return cmd
 }
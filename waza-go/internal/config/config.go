@@ -1,6 +1,9 @@
 package config
 
 import (
+	"time"
+
+	"github.com/spboyer/waza/waza-go/internal/filter"
 	"github.com/spboyer/waza/waza-go/internal/models"
 )
 
@@ -12,6 +15,33 @@ type BenchmarkConfig struct {
 	verbose    bool
 	outputPath string
 	logPath    string
+	filter     filter.Opts    // Focus/skip/label filtering applied before dispatching runs
+	runPlan    RunPlan        // Seeding, sharding and repeat/until-fail controls
+	vars       map[string]any // --var overrides, layered over the spec's own Variables
+}
+
+// RunPlan controls Ginkgo-style seeded shuffling, sharding and repeat
+// behavior for a benchmark run.
+type RunPlan struct {
+	// Seed seeds the deterministic shuffle used when Randomize is set. The
+	// seed is recorded on the resulting EvaluationOutcome so a flaky run can
+	// be reproduced exactly.
+	Seed int64
+	// Randomize shuffles the resolved test list using Seed before sharding
+	// or dispatching runs.
+	Randomize bool
+	// ShardIndex and ShardTotal split the (possibly shuffled) test list
+	// evenly across a CI matrix. ShardTotal <= 1 disables sharding.
+	ShardIndex int
+	ShardTotal int
+	// Repeat reruns the whole benchmark this many times, aggregating a
+	// combined outcome. Values <= 1 run the benchmark once.
+	Repeat int
+	// UntilFail ignores Repeat and instead reruns the benchmark until a
+	// test fails/errors or MaxDuration elapses.
+	UntilFail bool
+	// MaxDuration bounds how long UntilFail may loop. Zero means no bound.
+	MaxDuration time.Duration
 }
 
 // Option is a functional option for BenchmarkConfig
@@ -71,6 +101,28 @@ func WithLogPath(path string) Option {
 	}
 }
 
+// WithFilter sets the focus/skip/label filtering applied before dispatching runs
+func WithFilter(opts filter.Opts) Option {
+	return func(c *BenchmarkConfig) {
+		c.filter = opts
+	}
+}
+
+// WithRunPlan sets the seeding, sharding and repeat/until-fail controls
+func WithRunPlan(plan RunPlan) Option {
+	return func(c *BenchmarkConfig) {
+		c.runPlan = plan
+	}
+}
+
+// WithVars sets --var overrides layered over the spec's own Variables for
+// {{.var}} template interpolation
+func WithVars(vars map[string]any) Option {
+	return func(c *BenchmarkConfig) {
+		c.vars = vars
+	}
+}
+
 // Getters
 func (c *BenchmarkConfig) Spec() *models.BenchmarkSpec { return c.spec }
 func (c *BenchmarkConfig) SpecDir() string             { return c.specDir }
@@ -79,3 +131,6 @@ func (c *BenchmarkConfig) ContextRoot() string         { return c.fixtureDir } /
 func (c *BenchmarkConfig) Verbose() bool               { return c.verbose }
 func (c *BenchmarkConfig) OutputPath() string          { return c.outputPath }
 func (c *BenchmarkConfig) LogPath() string             { return c.logPath }
+func (c *BenchmarkConfig) Filter() filter.Opts         { return c.filter }
+func (c *BenchmarkConfig) RunPlan() RunPlan            { return c.runPlan }
+func (c *BenchmarkConfig) Vars() map[string]any        { return c.vars }
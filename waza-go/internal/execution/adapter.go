@@ -0,0 +1,59 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// Adapter is a pluggable test-execution backend. Unlike AgentEngine (which
+// always drives an LLM through a single Execute call and leaves grading to
+// the orchestration package's validators), an Adapter owns its whole
+// evaluate-and-grade pipeline, so non-LLM test targets — a compiled gtest
+// binary, a pytest suite, an arbitrary shell command — can report their own
+// pass/fail results directly instead of being graded after the fact.
+type Adapter interface {
+	// Build prepares the adapter for the given test cases (e.g. checking a
+	// binary exists, resolving the test runner on PATH). Called once before
+	// any Evaluate call.
+	Build(testCases []*models.TestCase) error
+
+	// Evaluate runs a single test case and returns its result. The caller
+	// fills in RunNumber.
+	Evaluate(ctx context.Context, tc *models.TestCase) (models.RunResult, error)
+}
+
+// AdapterConfig is the subset of a spec's config an adapter needs to
+// configure itself.
+type AdapterConfig struct {
+	ModelID    string
+	TimeoutSec int
+	// Command is the binary/script an adapter shells out to: the gtest
+	// binary path, the pytest executable, or an arbitrary command for exec.
+	Command string
+	// Args are extra arguments appended after the adapter's own flags.
+	Args []string
+}
+
+// AdapterFactory builds a new Adapter instance from an AdapterConfig.
+type AdapterFactory func(cfg AdapterConfig) Adapter
+
+var adapterRegistry = map[string]AdapterFactory{}
+
+// RegisterAdapter adds (or replaces) the factory for a named adapter, e.g.
+// "gtest" or "pytest". Third parties call this from their own init() to
+// plug in a new test target without touching this package.
+func RegisterAdapter(name string, factory AdapterFactory) {
+	adapterRegistry[name] = factory
+}
+
+// CreateAdapter instantiates the adapter registered for name. ok is false
+// when no adapter is registered under that name, so callers can fall back
+// to treating name as an AgentEngine type instead.
+func CreateAdapter(name string, cfg AdapterConfig) (adapter Adapter, ok bool) {
+	factory, ok := adapterRegistry[name]
+	if !ok {
+		return nil, false
+	}
+	return factory(cfg), true
+}
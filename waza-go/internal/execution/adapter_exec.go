@@ -0,0 +1,88 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func init() {
+	RegisterAdapter("exec", func(cfg AdapterConfig) Adapter {
+		return &execAdapter{command: cfg.Command, args: cfg.Args, timeoutSec: cfg.TimeoutSec}
+	})
+}
+
+// execAdapter runs an arbitrary command per test case, feeding the test's
+// stimulus message on stdin and exposing stdout/stderr/exit code to
+// graders via FinalOutput and a single "exit_code" validation.
+type execAdapter struct {
+	command    string
+	args       []string
+	timeoutSec int
+}
+
+func (a *execAdapter) Build(testCases []*models.TestCase) error {
+	if a.command == "" {
+		return fmt.Errorf("exec adapter requires a command")
+	}
+	if _, err := exec.LookPath(a.command); err != nil {
+		return fmt.Errorf("exec command %q not found: %w", a.command, err)
+	}
+	return nil
+}
+
+func (a *execAdapter) Evaluate(ctx context.Context, tc *models.TestCase) (models.RunResult, error) {
+	runCtx := ctx
+	if timeout := timeoutFor(tc, a.timeoutSec); timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(runCtx, a.command, a.args...)
+	cmd.Stdin = strings.NewReader(tc.Stimulus.Message)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	start := time.Now()
+	runErr := cmd.Run()
+	duration := time.Since(start).Milliseconds()
+
+	exitCode := 0
+	status := "passed"
+	errMsg := ""
+	if runErr != nil {
+		var exitErr *exec.ExitError
+		if errors.As(runErr, &exitErr) {
+			exitCode = exitErr.ExitCode()
+			status = "failed"
+		} else {
+			status = "error"
+		}
+		errMsg = runErr.Error()
+	}
+
+	return models.RunResult{
+		Status:      status,
+		DurationMs:  duration,
+		FinalOutput: stdout.String(),
+		ErrorMsg:    errMsg,
+		Validations: map[string]models.ValidationOut{
+			"exit_code": {
+				Identifier: "exit_code",
+				Kind:       "exec",
+				Passed:     exitCode == 0,
+				Score:      boolScore(exitCode == 0),
+				Feedback:   fmt.Sprintf("exit code %d; stderr: %s", exitCode, stderr.String()),
+			},
+		},
+	}, nil
+}
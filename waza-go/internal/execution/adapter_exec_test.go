@@ -0,0 +1,45 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func TestExecAdapter_Passes(t *testing.T) {
+	adapter := &execAdapter{command: "true"}
+	if err := adapter.Build(nil); err != nil {
+		t.Fatalf("unexpected Build error: %v", err)
+	}
+
+	run, err := adapter.Evaluate(context.Background(), &models.TestCase{TestID: "t1"})
+	if err != nil {
+		t.Fatalf("unexpected Evaluate error: %v", err)
+	}
+	if run.Status != "passed" {
+		t.Fatalf("expected passed, got %s", run.Status)
+	}
+	if !run.Validations["exit_code"].Passed {
+		t.Fatalf("expected exit_code validation to pass")
+	}
+}
+
+func TestExecAdapter_Fails(t *testing.T) {
+	adapter := &execAdapter{command: "false"}
+
+	run, err := adapter.Evaluate(context.Background(), &models.TestCase{TestID: "t1"})
+	if err != nil {
+		t.Fatalf("unexpected Evaluate error: %v", err)
+	}
+	if run.Status != "failed" {
+		t.Fatalf("expected failed, got %s", run.Status)
+	}
+}
+
+func TestExecAdapter_Build_MissingCommand(t *testing.T) {
+	adapter := &execAdapter{}
+	if err := adapter.Build(nil); err == nil {
+		t.Fatalf("expected error for missing command")
+	}
+}
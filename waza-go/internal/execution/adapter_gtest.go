@@ -0,0 +1,126 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func init() {
+	RegisterAdapter("gtest", func(cfg AdapterConfig) Adapter {
+		return &gtestAdapter{command: cfg.Command, args: cfg.Args, timeoutSec: cfg.TimeoutSec}
+	})
+}
+
+// gtestAdapter runs a compiled Google Test binary per test case, filtering
+// it down to that test's name and decoding its
+// --gtest_output=json:<path> report into Validations.
+type gtestAdapter struct {
+	command    string
+	args       []string
+	timeoutSec int
+}
+
+func (a *gtestAdapter) Build(testCases []*models.TestCase) error {
+	if a.command == "" {
+		return fmt.Errorf("gtest adapter requires a command (the compiled test binary path)")
+	}
+	if _, err := os.Stat(a.command); err != nil {
+		return fmt.Errorf("gtest binary not found: %w", err)
+	}
+	return nil
+}
+
+// gtestReport mirrors the subset of gtest's JSON report format this adapter
+// needs: https://google.github.io/googletest/advanced.html#generating-a-json-report
+type gtestReport struct {
+	Testsuites []struct {
+		Testsuite []struct {
+			Name     string `json:"name"`
+			Status   string `json:"status"`
+			Failures []struct {
+				Failure string `json:"failure"`
+			} `json:"failures,omitempty"`
+		} `json:"testsuite"`
+	} `json:"testsuites"`
+}
+
+func (a *gtestAdapter) Evaluate(ctx context.Context, tc *models.TestCase) (models.RunResult, error) {
+	runCtx := ctx
+	if timeout := timeoutFor(tc, a.timeoutSec); timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+
+	reportPath, cleanup, err := tempReportPath("gtest-report-*.json")
+	if err != nil {
+		return models.RunResult{}, err
+	}
+	defer cleanup()
+
+	args := append([]string{
+		fmt.Sprintf("--gtest_filter=%s", tc.TestID),
+		fmt.Sprintf("--gtest_output=json:%s", reportPath),
+	}, a.args...)
+
+	start := time.Now()
+	output, _ := exec.CommandContext(runCtx, a.command, args...).CombinedOutput()
+	duration := time.Since(start).Milliseconds()
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		return models.RunResult{
+			Status:      "error",
+			DurationMs:  duration,
+			FinalOutput: string(output),
+			ErrorMsg:    fmt.Sprintf("failed to read gtest report: %v", err),
+		}, nil
+	}
+
+	var report gtestReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return models.RunResult{
+			Status:      "error",
+			DurationMs:  duration,
+			FinalOutput: string(output),
+			ErrorMsg:    fmt.Sprintf("failed to decode gtest report: %v", err),
+		}, nil
+	}
+
+	validations := make(map[string]models.ValidationOut)
+	status := "passed"
+	for _, suite := range report.Testsuites {
+		for _, result := range suite.Testsuite {
+			passed := result.Status != "FAILED" && len(result.Failures) == 0
+			if !passed {
+				status = "failed"
+			}
+
+			feedback := ""
+			for _, f := range result.Failures {
+				feedback += f.Failure + "\n"
+			}
+
+			validations[result.Name] = models.ValidationOut{
+				Identifier: result.Name,
+				Kind:       "gtest",
+				Passed:     passed,
+				Score:      boolScore(passed),
+				Feedback:   feedback,
+			}
+		}
+	}
+
+	return models.RunResult{
+		Status:      status,
+		DurationMs:  duration,
+		FinalOutput: string(output),
+		Validations: validations,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func init() {
+	RegisterAdapter("pytest", func(cfg AdapterConfig) Adapter {
+		command := cfg.Command
+		if command == "" {
+			command = "pytest"
+		}
+		return &pytestAdapter{command: command, args: cfg.Args, timeoutSec: cfg.TimeoutSec}
+	})
+}
+
+// pytestAdapter runs a pytest node ID per test case and decodes its
+// pytest-json-report (--json-report) output into Validations.
+type pytestAdapter struct {
+	command    string
+	args       []string
+	timeoutSec int
+}
+
+func (a *pytestAdapter) Build(testCases []*models.TestCase) error {
+	if _, err := exec.LookPath(a.command); err != nil {
+		return fmt.Errorf("pytest command %q not found: %w", a.command, err)
+	}
+	return nil
+}
+
+// pytestReport mirrors the subset of pytest-json-report's schema this
+// adapter needs: https://pytest-json-report.readthedocs.io/
+type pytestReport struct {
+	Tests []struct {
+		Nodeid       string `json:"nodeid"`
+		Outcome      string `json:"outcome"`
+		CallLongRepr string `json:"longrepr"`
+	} `json:"tests"`
+}
+
+func (a *pytestAdapter) Evaluate(ctx context.Context, tc *models.TestCase) (models.RunResult, error) {
+	runCtx := ctx
+	if timeout := timeoutFor(tc, a.timeoutSec); timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+
+	reportPath, cleanup, err := tempReportPath("pytest-report-*.json")
+	if err != nil {
+		return models.RunResult{}, err
+	}
+	defer cleanup()
+
+	args := append([]string{
+		tc.TestID,
+		"--json-report",
+		fmt.Sprintf("--json-report-file=%s", reportPath),
+	}, a.args...)
+
+	start := time.Now()
+	output, _ := exec.CommandContext(runCtx, a.command, args...).CombinedOutput()
+	duration := time.Since(start).Milliseconds()
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		return models.RunResult{
+			Status:      "error",
+			DurationMs:  duration,
+			FinalOutput: string(output),
+			ErrorMsg:    fmt.Sprintf("failed to read pytest report: %v", err),
+		}, nil
+	}
+
+	var report pytestReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return models.RunResult{
+			Status:      "error",
+			DurationMs:  duration,
+			FinalOutput: string(output),
+			ErrorMsg:    fmt.Sprintf("failed to decode pytest report: %v", err),
+		}, nil
+	}
+
+	validations := make(map[string]models.ValidationOut)
+	status := "passed"
+	for _, test := range report.Tests {
+		passed := test.Outcome == "passed"
+		if !passed {
+			status = "failed"
+		}
+		validations[test.Nodeid] = models.ValidationOut{
+			Identifier: test.Nodeid,
+			Kind:       "pytest",
+			Passed:     passed,
+			Score:      boolScore(passed),
+			Feedback:   test.CallLongRepr,
+		}
+	}
+
+	return models.RunResult{
+		Status:      status,
+		DurationMs:  duration,
+		FinalOutput: string(output),
+		Validations: validations,
+	}, nil
+}
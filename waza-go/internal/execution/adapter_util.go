@@ -0,0 +1,40 @@
+package execution
+
+import (
+	"os"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// boolScore maps a pass/fail result to the 0/1 score validators use
+// elsewhere, so adapter-produced ValidationOut entries average the same way
+// as grader-produced ones.
+func boolScore(passed bool) float64 {
+	if passed {
+		return 1
+	}
+	return 0
+}
+
+// timeoutFor resolves a test case's effective timeout: its own override if
+// set, otherwise the adapter's configured default.
+func timeoutFor(tc *models.TestCase, defaultTimeoutSec int) int {
+	if tc.TimeoutSec != nil {
+		return *tc.TimeoutSec
+	}
+	return defaultTimeoutSec
+}
+
+// tempReportPath creates an empty temp file matching pattern (e.g.
+// "gtest-report-*.json") for a subprocess to write its report into, and
+// returns a cleanup func to remove it.
+func tempReportPath(pattern string) (path string, cleanup func(), err error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", nil, err
+	}
+	path = f.Name()
+	f.Close()
+
+	return path, func() { os.Remove(path) }, nil
+}
@@ -0,0 +1,144 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// AnthropicEngine drives a test against the Anthropic Messages API.
+type AnthropicEngine struct {
+	modelID string
+	apiKey  string
+	baseURL string
+	version string
+}
+
+// AnthropicEngineParams mirrors the params block accepted from the
+// `engine:` section of a benchmark spec.
+type AnthropicEngineParams struct {
+	APIKey  string `mapstructure:"api_key"`
+	BaseURL string `mapstructure:"base_url"`
+	Version string `mapstructure:"version"`
+}
+
+// NewAnthropicEngine creates an AnthropicEngine. The API key may be
+// supplied via params or the ANTHROPIC_API_KEY environment variable.
+func NewAnthropicEngine(modelID string, params map[string]any) (*AnthropicEngine, error) {
+	var p AnthropicEngineParams
+	if err := mapstructure.Decode(params, &p); err != nil {
+		return nil, err
+	}
+
+	apiKey := p.APIKey
+	if apiKey == "" {
+		apiKey = apiKeyFrom(params, "api_key", "ANTHROPIC_API_KEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("anthropic engine requires an api key (params.api_key or ANTHROPIC_API_KEY)")
+	}
+
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.anthropic.com/v1"
+	}
+
+	version := p.Version
+	if version == "" {
+		version = "2023-06-01"
+	}
+
+	return &AnthropicEngine{modelID: modelID, apiKey: apiKey, baseURL: baseURL, version: version}, nil
+}
+
+func (e *AnthropicEngine) Initialize(ctx context.Context) error { return nil }
+func (e *AnthropicEngine) Shutdown(ctx context.Context) error   { return nil }
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicMessagesRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	Messages  []anthropicMessage `json:"messages"`
+}
+
+type anthropicContentBlock struct {
+	Type  string `json:"type"`
+	Text  string `json:"text,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Input any    `json:"input,omitempty"`
+}
+
+type anthropicMessagesResponse struct {
+	Content    []anthropicContentBlock `json:"content"`
+	StopReason string                  `json:"stop_reason"`
+	Usage      struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+}
+
+// Execute sends req.Message as a single user turn and translates the
+// response's content blocks (text and tool_use) into SessionEvents.
+func (e *AnthropicEngine) Execute(ctx context.Context, req *ExecutionRequest) (*ExecutionResponse, error) {
+	start := time.Now()
+
+	msgReq := anthropicMessagesRequest{
+		Model:     e.modelID,
+		MaxTokens: 4096,
+		Messages: []anthropicMessage{
+			{Role: "user", Content: req.Message},
+		},
+	}
+
+	var msgResp anthropicMessagesResponse
+	headers := map[string]string{
+		"x-api-key":         e.apiKey,
+		"anthropic-version": e.version,
+	}
+	if err := postJSON(ctx, e.baseURL+"/messages", headers, msgReq, &msgResp); err != nil {
+		return nil, fmt.Errorf("anthropic engine execute failed: %w", err)
+	}
+
+	var events []SessionEvent
+	var toolCalls []ToolCall
+	var output string
+
+	for _, block := range msgResp.Content {
+		switch block.Type {
+		case "text":
+			output += block.Text
+			events = append(events, SessionEvent{
+				EventType: "assistant.message",
+				Timestamp: time.Now(),
+				Payload:   map[string]any{"content": block.Text},
+			})
+		case "tool_use":
+			args, _ := block.Input.(map[string]any)
+			events = append(events, SessionEvent{
+				EventType: "tool.execution_start",
+				Timestamp: time.Now(),
+				Payload:   map[string]any{"toolName": block.Name, "arguments": args},
+			})
+			toolCalls = append(toolCalls, ToolCall{Name: block.Name, Arguments: args})
+		}
+	}
+
+	events = append(events, SessionEvent{EventType: "session.idle", Timestamp: time.Now()})
+
+	return &ExecutionResponse{
+		FinalOutput:  output,
+		Events:       events,
+		ModelID:      e.modelID,
+		SkillInvoked: req.SkillName,
+		DurationMs:   time.Since(start).Milliseconds(),
+		ToolCalls:    toolCalls,
+		Success:      true,
+		Seed:         req.Seed,
+	}, nil
+}
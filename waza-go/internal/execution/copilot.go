@@ -195,7 +195,10 @@ func (e *CopilotEngine) Execute(ctx context.Context, req *ExecutionRequest) (*Ex
 
 	duration := time.Since(start)
 
-	// Build response
+	// Build response. copilot.SessionConfig has no seed parameter today, so
+	// req.Seed can't actually be forwarded to the model; it's only echoed
+	// back here for RunResult bookkeeping so "waza replay" can at least
+	// record which seed a failing run was supposed to use.
 	resp := &ExecutionResponse{
 		FinalOutput:  joinStrings(outputParts),
 		Events:       events,
@@ -205,6 +208,7 @@ func (e *CopilotEngine) Execute(ctx context.Context, req *ExecutionRequest) (*Ex
 		ToolCalls:    extractToolCalls(events),
 		ErrorMsg:     errorMsg,
 		Success:      errorMsg == "",
+		Seed:         req.Seed,
 	}
 
 	return resp, nil
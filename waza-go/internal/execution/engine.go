@@ -26,6 +26,11 @@ type ExecutionRequest struct {
 	Resources  []ResourceFile
 	SkillName  string
 	TimeoutSec int
+	// Seed is a deterministic per-run seed derived from the benchmark's base
+	// seed, the test ID and the run number (see orchestration.TestRunner).
+	// Engines that support it should forward it to the model as a request
+	// parameter and echo it back on ExecutionResponse.Seed.
+	Seed int64
 }
 
 // ResourceFile represents a file resource
@@ -44,6 +49,9 @@ type ExecutionResponse struct {
 	ToolCalls    []ToolCall
 	ErrorMsg     string
 	Success      bool
+	// Seed echoes back ExecutionRequest.Seed so callers can confirm (and
+	// record on the RunResult) which seed the engine actually used.
+	Seed int64
 }
 
 // SessionEvent represents an event during execution
@@ -0,0 +1,49 @@
+package execution
+
+import "testing"
+
+func TestOpenAIEngine_RequiresAPIKey(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "")
+	if _, err := NewOpenAIEngine("gpt-4o", nil); err == nil {
+		t.Fatalf("expected an error when no api key is available")
+	}
+}
+
+func TestOpenAIEngine_AcceptsAPIKeyFromParams(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "")
+	engine, err := NewOpenAIEngine("gpt-4o", map[string]any{"api_key": "test-key"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if engine.baseURL != "https://api.openai.com/v1" {
+		t.Fatalf("expected default base url, got %q", engine.baseURL)
+	}
+}
+
+func TestAnthropicEngine_RequiresAPIKey(t *testing.T) {
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	if _, err := NewAnthropicEngine("claude-3", nil); err == nil {
+		t.Fatalf("expected an error when no api key is available")
+	}
+}
+
+func TestAnthropicEngine_AcceptsAPIKeyFromParams(t *testing.T) {
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	engine, err := NewAnthropicEngine("claude-3", map[string]any{"api_key": "test-key"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if engine.version != "2023-06-01" {
+		t.Fatalf("expected default anthropic-version, got %q", engine.version)
+	}
+}
+
+func TestOllamaEngine_DefaultsBaseURL(t *testing.T) {
+	engine, err := NewOllamaEngine("llama3", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if engine.baseURL != "http://localhost:11434" {
+		t.Fatalf("expected default base url, got %q", engine.baseURL)
+	}
+}
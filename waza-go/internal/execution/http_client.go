@@ -0,0 +1,63 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// apiKeyFrom resolves an API key from params[paramKey], falling back to the
+// named environment variable. Backends use this so a spec can either
+// embed a key directly (params) or rely on the operator's shell env.
+func apiKeyFrom(params map[string]any, paramKey, envVar string) string {
+	if v, ok := params[paramKey].(string); ok && v != "" {
+		return v
+	}
+	return os.Getenv(envVar)
+}
+
+// postJSON sends body as a JSON POST to url with the given headers and
+// decodes the JSON response into out.
+func postJSON(ctx context.Context, url string, headers map[string]string, body any, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request body: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("request to %s failed with status %d: %s", url, resp.StatusCode, string(respBody))
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
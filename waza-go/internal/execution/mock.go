@@ -33,6 +33,12 @@ func (m *MockEngine) Execute(ctx context.Context, req *ExecutionRequest) (*Execu
 		output += fmt.Sprintf("\nAnalyzed %d file(s)", len(req.Resources))
 	}
 
+	// Forward the per-run seed to the "model" so a replayed run with the
+	// same seed produces the same output.
+	if req.Seed != 0 {
+		output += fmt.Sprintf("\nSeed: %d", req.Seed)
+	}
+
 	resp := &ExecutionResponse{
 		FinalOutput:  output,
 		Events:       []SessionEvent{},
@@ -41,6 +47,7 @@ func (m *MockEngine) Execute(ctx context.Context, req *ExecutionRequest) (*Execu
 		DurationMs:   time.Since(start).Milliseconds(),
 		ToolCalls:    []ToolCall{},
 		Success:      true,
+		Seed:         req.Seed,
 	}
 
 	return resp, nil
@@ -0,0 +1,85 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// OllamaEngine drives a test against a local Ollama server, so evaluations
+// can run entirely offline against a self-hosted model.
+type OllamaEngine struct {
+	modelID string
+	baseURL string
+}
+
+// OllamaEngineParams mirrors the params block accepted from the `engine:`
+// section of a benchmark spec.
+type OllamaEngineParams struct {
+	BaseURL string `mapstructure:"base_url"`
+}
+
+// NewOllamaEngine creates an OllamaEngine pointed at baseURL (default
+// http://localhost:11434).
+func NewOllamaEngine(modelID string, params map[string]any) (*OllamaEngine, error) {
+	var p OllamaEngineParams
+	if err := mapstructure.Decode(params, &p); err != nil {
+		return nil, err
+	}
+
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = "http://localhost:11434"
+	}
+
+	return &OllamaEngine{modelID: modelID, baseURL: baseURL}, nil
+}
+
+func (e *OllamaEngine) Initialize(ctx context.Context) error { return nil }
+func (e *OllamaEngine) Shutdown(ctx context.Context) error   { return nil }
+
+type ollamaGenerateRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+type ollamaGenerateResponse struct {
+	Response string `json:"response"`
+	Done     bool   `json:"done"`
+}
+
+// Execute issues a non-streaming /api/generate call and wraps the reply in
+// the shared SessionEvent shape.
+func (e *OllamaEngine) Execute(ctx context.Context, req *ExecutionRequest) (*ExecutionResponse, error) {
+	start := time.Now()
+
+	genReq := ollamaGenerateRequest{
+		Model:  e.modelID,
+		Prompt: req.Message,
+		Stream: false,
+	}
+
+	var genResp ollamaGenerateResponse
+	if err := postJSON(ctx, e.baseURL+"/api/generate", nil, genReq, &genResp); err != nil {
+		return nil, fmt.Errorf("ollama engine execute failed: %w", err)
+	}
+
+	events := []SessionEvent{
+		{EventType: "assistant.message", Timestamp: time.Now(), Payload: map[string]any{"content": genResp.Response}},
+		{EventType: "session.idle", Timestamp: time.Now()},
+	}
+
+	return &ExecutionResponse{
+		FinalOutput:  genResp.Response,
+		Events:       events,
+		ModelID:      e.modelID,
+		SkillInvoked: req.SkillName,
+		DurationMs:   time.Since(start).Milliseconds(),
+		ToolCalls:    []ToolCall{},
+		Success:      genResp.Done,
+		Seed:         req.Seed,
+	}, nil
+}
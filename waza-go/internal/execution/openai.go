@@ -0,0 +1,136 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// OpenAIEngine drives a test against the OpenAI chat.completions API.
+type OpenAIEngine struct {
+	modelID string
+	apiKey  string
+	baseURL string
+}
+
+// OpenAIEngineParams mirrors the params block accepted from the `engine:`
+// section of a benchmark spec.
+type OpenAIEngineParams struct {
+	APIKey  string `mapstructure:"api_key"`
+	BaseURL string `mapstructure:"base_url"`
+}
+
+// NewOpenAIEngine creates an OpenAIEngine. The API key may be supplied via
+// params or the OPENAI_API_KEY environment variable.
+func NewOpenAIEngine(modelID string, params map[string]any) (*OpenAIEngine, error) {
+	var p OpenAIEngineParams
+	if err := mapstructure.Decode(params, &p); err != nil {
+		return nil, err
+	}
+
+	apiKey := p.APIKey
+	if apiKey == "" {
+		apiKey = apiKeyFrom(params, "api_key", "OPENAI_API_KEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("openai engine requires an api key (params.api_key or OPENAI_API_KEY)")
+	}
+
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.openai.com/v1"
+	}
+
+	return &OpenAIEngine{modelID: modelID, apiKey: apiKey, baseURL: baseURL}, nil
+}
+
+func (e *OpenAIEngine) Initialize(ctx context.Context) error { return nil }
+func (e *OpenAIEngine) Shutdown(ctx context.Context) error   { return nil }
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatRequest struct {
+	Model    string              `json:"model"`
+	Messages []openAIChatMessage `json:"messages"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content   string `json:"content"`
+			ToolCalls []struct {
+				Function struct {
+					Name      string `json:"name"`
+					Arguments string `json:"arguments"`
+				} `json:"function"`
+			} `json:"tool_calls"`
+		} `json:"message"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+// Execute sends req.Message as a single user turn and translates the
+// response into the shared SessionEvent shape.
+func (e *OpenAIEngine) Execute(ctx context.Context, req *ExecutionRequest) (*ExecutionResponse, error) {
+	start := time.Now()
+
+	chatReq := openAIChatRequest{
+		Model: e.modelID,
+		Messages: []openAIChatMessage{
+			{Role: "user", Content: req.Message},
+		},
+	}
+
+	var chatResp openAIChatResponse
+	headers := map[string]string{"Authorization": "Bearer " + e.apiKey}
+	if err := postJSON(ctx, e.baseURL+"/chat/completions", headers, chatReq, &chatResp); err != nil {
+		return nil, fmt.Errorf("openai engine execute failed: %w", err)
+	}
+
+	if len(chatResp.Choices) == 0 {
+		return nil, fmt.Errorf("openai engine received no choices in response")
+	}
+
+	choice := chatResp.Choices[0]
+
+	var events []SessionEvent
+	var toolCalls []ToolCall
+
+	if choice.Message.Content != "" {
+		events = append(events, SessionEvent{
+			EventType: "assistant.message",
+			Timestamp: time.Now(),
+			Payload:   map[string]any{"content": choice.Message.Content},
+		})
+	}
+
+	for _, tc := range choice.Message.ToolCalls {
+		events = append(events, SessionEvent{
+			EventType: "tool.execution_start",
+			Timestamp: time.Now(),
+			Payload:   map[string]any{"toolName": tc.Function.Name, "arguments": tc.Function.Arguments},
+		})
+		toolCalls = append(toolCalls, ToolCall{Name: tc.Function.Name})
+	}
+
+	events = append(events, SessionEvent{EventType: "session.idle", Timestamp: time.Now()})
+
+	return &ExecutionResponse{
+		FinalOutput:  choice.Message.Content,
+		Events:       events,
+		ModelID:      e.modelID,
+		SkillInvoked: req.SkillName,
+		DurationMs:   time.Since(start).Milliseconds(),
+		ToolCalls:    toolCalls,
+		Success:      true,
+		Seed:         req.Seed,
+	}, nil
+}
@@ -0,0 +1,163 @@
+package filter
+
+import "fmt"
+
+// expr is a parsed label-filter expression, evaluated against a test's set
+// of labels. Grammar (modeled on Ginkgo's --label-filter):
+//
+//	expr   := term (" || " term)*
+//	term   := factor (" && " factor)*
+//	factor := "!" factor | "(" expr ")" | IDENT
+type expr interface {
+	eval(labels map[string]bool) bool
+}
+
+type identExpr string
+
+func (e identExpr) eval(labels map[string]bool) bool { return labels[string(e)] }
+
+type notExpr struct{ x expr }
+
+func (e notExpr) eval(labels map[string]bool) bool { return !e.x.eval(labels) }
+
+type andExpr struct{ l, r expr }
+
+func (e andExpr) eval(labels map[string]bool) bool { return e.l.eval(labels) && e.r.eval(labels) }
+
+type orExpr struct{ l, r expr }
+
+func (e orExpr) eval(labels map[string]bool) bool { return e.l.eval(labels) || e.r.eval(labels) }
+
+// parseLabelExpr parses a boolean label expression such as
+// "smoke && !slow" or "(regression || nightly) && tier1".
+func parseLabelExpr(s string) (expr, error) {
+	p := &exprParser{tokens: tokenize(s), src: s}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("label expression %q: unexpected token %q", s, p.tokens[p.pos])
+	}
+	return e, nil
+}
+
+type exprParser struct {
+	tokens []string
+	pos    int
+	src    string
+}
+
+func (p *exprParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *exprParser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "||" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{l: left, r: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "&&" {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{l: left, r: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (expr, error) {
+	if p.peek() == "!" {
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (expr, error) {
+	tok := p.peek()
+	switch tok {
+	case "":
+		return nil, fmt.Errorf("label expression %q: unexpected end of input", p.src)
+	case "(":
+		p.next()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("label expression %q: expected ')'", p.src)
+		}
+		p.next()
+		return e, nil
+	case ")", "&&", "||":
+		return nil, fmt.Errorf("label expression %q: unexpected token %q", p.src, tok)
+	default:
+		p.next()
+		return identExpr(tok), nil
+	}
+}
+
+// tokenize splits a label expression into identifier, operator, and
+// parenthesis tokens, skipping whitespace.
+func tokenize(s string) []string {
+	var tokens []string
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(' || c == ')' || c == '!':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '&' && i+1 < len(runes) && runes[i+1] == '&':
+			tokens = append(tokens, "&&")
+			i += 2
+		case c == '|' && i+1 < len(runes) && runes[i+1] == '|':
+			tokens = append(tokens, "||")
+			i += 2
+		default:
+			start := i
+			for i < len(runes) && runes[i] != ' ' && runes[i] != '\t' && runes[i] != '\n' &&
+				runes[i] != '(' && runes[i] != ')' && runes[i] != '!' &&
+				!(runes[i] == '&' && i+1 < len(runes) && runes[i+1] == '&') &&
+				!(runes[i] == '|' && i+1 < len(runes) && runes[i+1] == '|') {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		}
+	}
+	return tokens
+}
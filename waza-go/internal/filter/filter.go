@@ -0,0 +1,114 @@
+// Package filter selects a subset of test cases to run, borrowing the
+// --focus/--skip/--label-filter model from Ginkgo. Focus and skip match
+// regular expressions against a test's ID and display name; labels match a
+// boolean expression against the test's label set.
+package filter
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+	"github.com/spboyer/waza/waza-go/internal/testmatch"
+)
+
+// Opts configures Filter.
+type Opts struct {
+	// Focus, if set, keeps only tests whose TestID or DisplayName match the
+	// regular expression.
+	Focus string
+	// Skip, if set, drops tests whose TestID or DisplayName match the
+	// regular expression. Skip is evaluated before Focus.
+	Skip string
+	// Labels, if set, keeps only tests whose Labels satisfy the boolean
+	// expression (e.g. "smoke && !slow", "(regression || nightly) && tier1").
+	Labels string
+
+	// Run, if set, keeps only tests whose slash-separated TestID matches
+	// this go-test-style selector (e.g. "auth/.*login.*"); see
+	// testmatch.Matcher. Evaluated together with RunSkip and Tags.
+	Run string
+	// RunSkip, if set, drops tests whose slash-separated TestID matches
+	// this go-test-style selector, taking precedence over Run.
+	RunSkip string
+	// Tags, if set, keeps only tests with at least one Label in this
+	// comma-separated selector (e.g. "@slow,@integration").
+	Tags string
+}
+
+// Skipped records a test case excluded by Filter and the reason why.
+type Skipped struct {
+	TestCase *models.TestCase
+	Reason   string
+}
+
+// Filter splits tests into the subset that should run and the subset
+// excluded by opts, along with a human-readable reason for each exclusion
+// so callers can report "filtered out" separately from "disabled".
+func Filter(tests []*models.TestCase, opts Opts) (kept []*models.TestCase, skipped []Skipped, err error) {
+	var focusRe, skipRe *regexp.Regexp
+	if opts.Focus != "" {
+		focusRe, err = regexp.Compile(opts.Focus)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --focus pattern %q: %w", opts.Focus, err)
+		}
+	}
+	if opts.Skip != "" {
+		skipRe, err = regexp.Compile(opts.Skip)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --skip pattern %q: %w", opts.Skip, err)
+		}
+	}
+
+	var labelExpr expr
+	if opts.Labels != "" {
+		labelExpr, err = parseLabelExpr(opts.Labels)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var matcher *testmatch.Matcher
+	if opts.Run != "" || opts.RunSkip != "" || opts.Tags != "" {
+		matcher, err = testmatch.NewMatcher(opts.Run, opts.RunSkip, opts.Tags)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, tc := range tests {
+		if skipRe != nil && (skipRe.MatchString(tc.TestID) || skipRe.MatchString(tc.DisplayName)) {
+			skipped = append(skipped, Skipped{TestCase: tc, Reason: fmt.Sprintf("matched --skip %q", opts.Skip)})
+			continue
+		}
+		if focusRe != nil && !focusRe.MatchString(tc.TestID) && !focusRe.MatchString(tc.DisplayName) {
+			skipped = append(skipped, Skipped{TestCase: tc, Reason: fmt.Sprintf("did not match --focus %q", opts.Focus)})
+			continue
+		}
+		if labelExpr != nil && !labelExpr.eval(labelSet(tc.Labels)) {
+			skipped = append(skipped, Skipped{TestCase: tc, Reason: fmt.Sprintf("did not match --labels %q", opts.Labels)})
+			continue
+		}
+		if matcher != nil {
+			if run, skip := matcher.Match(tc.TestID, tc.Labels); !run {
+				reason := "skipped by selector: did not match --run or --tag"
+				if skip {
+					reason = fmt.Sprintf("skipped by selector: matched --run-skip %q", opts.RunSkip)
+				}
+				skipped = append(skipped, Skipped{TestCase: tc, Reason: reason})
+				continue
+			}
+		}
+		kept = append(kept, tc)
+	}
+
+	return kept, skipped, nil
+}
+
+func labelSet(labels []string) map[string]bool {
+	set := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		set[l] = true
+	}
+	return set
+}
@@ -0,0 +1,126 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func tc(id, name string, labels ...string) *models.TestCase {
+	return &models.TestCase{TestID: id, DisplayName: name, Labels: labels}
+}
+
+func TestFilter_NoOpts(t *testing.T) {
+	tests := []*models.TestCase{tc("a", "Test A"), tc("b", "Test B")}
+	kept, skipped, err := Filter(tests, Opts{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 2 || len(skipped) != 0 {
+		t.Fatalf("expected all tests kept, got kept=%d skipped=%d", len(kept), len(skipped))
+	}
+}
+
+func TestFilter_Focus(t *testing.T) {
+	tests := []*models.TestCase{tc("login-001", "Login succeeds"), tc("logout-001", "Logout succeeds")}
+	kept, skipped, err := Filter(tests, Opts{Focus: "^login"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 1 || kept[0].TestID != "login-001" {
+		t.Fatalf("expected only login-001 kept, got %v", kept)
+	}
+	if len(skipped) != 1 || skipped[0].TestCase.TestID != "logout-001" {
+		t.Fatalf("expected logout-001 skipped, got %v", skipped)
+	}
+}
+
+func TestFilter_Skip(t *testing.T) {
+	tests := []*models.TestCase{tc("a", "Test A"), tc("b", "Test B (slow)")}
+	kept, skipped, err := Filter(tests, Opts{Skip: "slow"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 1 || kept[0].TestID != "a" {
+		t.Fatalf("expected only a kept, got %v", kept)
+	}
+	if len(skipped) != 1 {
+		t.Fatalf("expected 1 skipped, got %d", len(skipped))
+	}
+}
+
+func TestFilter_Labels(t *testing.T) {
+	tests := []*models.TestCase{
+		tc("a", "A", "smoke"),
+		tc("b", "B", "smoke", "slow"),
+		tc("c", "C", "regression", "tier1"),
+		tc("d", "D", "nightly", "tier1"),
+		tc("e", "E", "nightly"),
+	}
+
+	kept, _, err := Filter(tests, Opts{Labels: "smoke && !slow"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 1 || kept[0].TestID != "a" {
+		t.Fatalf("expected only a kept, got %v", kept)
+	}
+
+	kept, _, err = Filter(tests, Opts{Labels: "(regression || nightly) && tier1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 2 {
+		t.Fatalf("expected c and d kept, got %v", kept)
+	}
+}
+
+func TestFilter_RunSelector(t *testing.T) {
+	tests := []*models.TestCase{tc("auth/login_basic", "Login"), tc("auth/logout", "Logout"), tc("billing/charge", "Charge")}
+	kept, skipped, err := Filter(tests, Opts{Run: "auth/.*login.*"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 1 || kept[0].TestID != "auth/login_basic" {
+		t.Fatalf("expected only auth/login_basic kept, got %v", kept)
+	}
+	if len(skipped) != 2 {
+		t.Fatalf("expected 2 skipped, got %d", len(skipped))
+	}
+}
+
+func TestFilter_RunSkipSelector(t *testing.T) {
+	tests := []*models.TestCase{tc("flaky/retry", "Retry"), tc("stable/retry", "Retry")}
+	kept, _, err := Filter(tests, Opts{RunSkip: "flaky/.*"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 1 || kept[0].TestID != "stable/retry" {
+		t.Fatalf("expected only stable/retry kept, got %v", kept)
+	}
+}
+
+func TestFilter_TagSelector(t *testing.T) {
+	tests := []*models.TestCase{tc("a", "A", "@slow"), tc("b", "B", "@fast")}
+	kept, _, err := Filter(tests, Opts{Tags: "@slow,@integration"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 1 || kept[0].TestID != "a" {
+		t.Fatalf("expected only a kept, got %v", kept)
+	}
+}
+
+func TestFilter_InvalidFocusPattern(t *testing.T) {
+	_, _, err := Filter(nil, Opts{Focus: "("})
+	if err == nil {
+		t.Fatal("expected an error for an invalid --focus pattern")
+	}
+}
+
+func TestFilter_InvalidLabelExpr(t *testing.T) {
+	_, _, err := Filter(nil, Opts{Labels: "smoke &&"})
+	if err == nil {
+		t.Fatal("expected an error for a malformed label expression")
+	}
+}
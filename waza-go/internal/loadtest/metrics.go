@@ -0,0 +1,88 @@
+package loadtest
+
+import (
+	"sort"
+	"time"
+)
+
+// StrategyMetrics summarizes one strategy's completed run.
+type StrategyMetrics struct {
+	StrategyName      string  `json:"strategy_name"`
+	TotalRequests     int     `json:"total_requests"`
+	Errors            int     `json:"errors"`
+	ErrorRate         float64 `json:"error_rate"`
+	ValidatorPassRate float64 `json:"validator_pass_rate"`
+	ThroughputPerSec  float64 `json:"throughput_per_sec"`
+	ToolCallsPerSec   float64 `json:"tool_calls_per_sec"`
+	P50LatencyMs      int64   `json:"p50_latency_ms"`
+	P95LatencyMs      int64   `json:"p95_latency_ms"`
+	P99LatencyMs      int64   `json:"p99_latency_ms"`
+}
+
+// Sample is one executed request's raw measurement, collected as a
+// strategy runs and reduced into a StrategyMetrics once it finishes.
+//
+// AgentEngine doesn't report token counts, so there's no TokensPerSec here
+// alongside ToolCallsPerSec; only the tool-call rate can be measured today.
+type Sample struct {
+	LatencyMs int64
+	ToolCalls int
+	Passed    bool
+	Errored   bool
+}
+
+// Reduce summarizes samples collected over elapsed wall-clock time into a
+// StrategyMetrics.
+func Reduce(strategyName string, samples []Sample, elapsed time.Duration) StrategyMetrics {
+	if len(samples) == 0 {
+		return StrategyMetrics{StrategyName: strategyName}
+	}
+
+	latencies := make([]int64, len(samples))
+	errors := 0
+	passed := 0
+	totalToolCalls := 0
+	for i, s := range samples {
+		latencies[i] = s.LatencyMs
+		if s.Errored {
+			errors++
+		}
+		if s.Passed {
+			passed++
+		}
+		totalToolCalls += s.ToolCalls
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	seconds := elapsed.Seconds()
+	throughput := 0.0
+	toolCallRate := 0.0
+	if seconds > 0 {
+		throughput = float64(len(samples)) / seconds
+		toolCallRate = float64(totalToolCalls) / seconds
+	}
+
+	return StrategyMetrics{
+		StrategyName:      strategyName,
+		TotalRequests:     len(samples),
+		Errors:            errors,
+		ErrorRate:         float64(errors) / float64(len(samples)),
+		ValidatorPassRate: float64(passed) / float64(len(samples)),
+		ThroughputPerSec:  throughput,
+		ToolCallsPerSec:   toolCallRate,
+		P50LatencyMs:      percentile(latencies, 0.50),
+		P95LatencyMs:      percentile(latencies, 0.95),
+		P99LatencyMs:      percentile(latencies, 0.99),
+	}
+}
+
+// percentile returns the p-th percentile of sorted (already-ascending)
+// latencies as the element at index floor(p*(len-1)), without
+// interpolating between neighbouring samples.
+func percentile(sorted []int64, p float64) int64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(p * float64(len(sorted)-1))
+	return sorted[idx]
+}
@@ -0,0 +1,43 @@
+package loadtest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReduce_Empty(t *testing.T) {
+	metrics := Reduce("s1", nil, time.Second)
+	if metrics.TotalRequests != 0 {
+		t.Fatalf("expected 0 total requests, got %d", metrics.TotalRequests)
+	}
+}
+
+func TestReduce_Percentiles(t *testing.T) {
+	samples := []Sample{
+		{LatencyMs: 100, Passed: true},
+		{LatencyMs: 200, Passed: true},
+		{LatencyMs: 300, Errored: true},
+		{LatencyMs: 400, Passed: true},
+	}
+
+	metrics := Reduce("s1", samples, 2*time.Second)
+
+	if metrics.TotalRequests != 4 {
+		t.Fatalf("expected 4 total requests, got %d", metrics.TotalRequests)
+	}
+	if metrics.Errors != 1 {
+		t.Fatalf("expected 1 error, got %d", metrics.Errors)
+	}
+	if metrics.ErrorRate != 0.25 {
+		t.Fatalf("expected error rate 0.25, got %v", metrics.ErrorRate)
+	}
+	if metrics.ValidatorPassRate != 0.75 {
+		t.Fatalf("expected validator pass rate 0.75, got %v", metrics.ValidatorPassRate)
+	}
+	if metrics.P50LatencyMs != 200 {
+		t.Fatalf("expected p50 200ms, got %d", metrics.P50LatencyMs)
+	}
+	if metrics.ThroughputPerSec != 2 {
+		t.Fatalf("expected throughput 2/sec, got %v", metrics.ThroughputPerSec)
+	}
+}
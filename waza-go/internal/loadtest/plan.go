@@ -0,0 +1,48 @@
+// Package loadtest describes and measures stress-test workloads run against
+// an execution.AgentEngine: the data types for a "run plan" (this file) and
+// the weighting/rate-limiting/metrics building blocks the orchestration
+// package's load-test runner composes (weighted.go, ratelimiter.go,
+// metrics.go).
+package loadtest
+
+import (
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Plan describes a load test as a sequence of named strategies run one
+// after another.
+type Plan struct {
+	Name       string     `yaml:"name" json:"name"`
+	Strategies []Strategy `yaml:"strategies" json:"strategies"`
+}
+
+// Strategy is one phase of a load test: Concurrency virtual users
+// repeatedly pick a weighted-random test case and execute it, capped by a
+// token-bucket rate limiter, until Duration elapses or Iterations requests
+// have been dispatched (whichever comes first; zero means no limit).
+type Strategy struct {
+	Name        string             `yaml:"name" json:"name"`
+	Concurrency int                `yaml:"concurrency" json:"concurrency"`
+	RatePerSec  float64            `yaml:"rate_per_sec,omitempty" json:"rate_per_sec,omitempty"`
+	Duration    time.Duration      `yaml:"duration,omitempty" json:"duration,omitempty"`
+	Iterations  int                `yaml:"iterations,omitempty" json:"iterations,omitempty"`
+	Weights     map[string]float64 `yaml:"weights,omitempty" json:"weights,omitempty"`
+}
+
+// LoadPlan loads a load-test plan from a YAML file.
+func LoadPlan(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan Plan
+	if err := yaml.Unmarshal(data, &plan); err != nil {
+		return nil, err
+	}
+
+	return &plan, nil
+}
@@ -0,0 +1,59 @@
+package loadtest
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token-bucket limiter: Wait blocks until a token is
+// available, refilling continuously at RatePerSec. A non-positive rate
+// disables limiting entirely.
+type RateLimiter struct {
+	mu         sync.Mutex
+	ratePerSec float64
+	tokens     float64
+	last       time.Time
+}
+
+// NewRateLimiter builds a RateLimiter starting with a full bucket.
+func NewRateLimiter(ratePerSec float64) *RateLimiter {
+	return &RateLimiter{ratePerSec: ratePerSec, tokens: ratePerSec, last: time.Now()}
+}
+
+// Wait blocks until a token is available or ctx is canceled.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l.ratePerSec <= 0 {
+		return nil
+	}
+
+	// The bucket holds at least one token so a rate below 1/sec still
+	// grants a request every 1/ratePerSec seconds.
+	capacity := l.ratePerSec
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.ratePerSec
+		if l.tokens > capacity {
+			l.tokens = capacity
+		}
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
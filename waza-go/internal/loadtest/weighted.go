@@ -0,0 +1,45 @@
+package loadtest
+
+import (
+	"math/rand"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// WeightedPicker selects a test case at random, favoring tests with a
+// higher configured weight. Tests not named in the strategy's weight map
+// default to weight 1.
+type WeightedPicker struct {
+	testCases []*models.TestCase
+	weights   []float64
+	total     float64
+}
+
+// NewWeightedPicker builds a WeightedPicker over testCases using
+// weightsByID (test ID -> weight).
+func NewWeightedPicker(testCases []*models.TestCase, weightsByID map[string]float64) *WeightedPicker {
+	p := &WeightedPicker{testCases: testCases, weights: make([]float64, len(testCases))}
+
+	for i, tc := range testCases {
+		w, ok := weightsByID[tc.TestID]
+		if !ok || w <= 0 {
+			w = 1
+		}
+		p.weights[i] = w
+		p.total += w
+	}
+
+	return p
+}
+
+// Pick returns one test case, weighted by its configured share of total.
+func (p *WeightedPicker) Pick(rng *rand.Rand) *models.TestCase {
+	r := rng.Float64() * p.total
+	for i, w := range p.weights {
+		r -= w
+		if r <= 0 {
+			return p.testCases[i]
+		}
+	}
+	return p.testCases[len(p.testCases)-1]
+}
@@ -0,0 +1,36 @@
+package loadtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func TestWeightedPicker_AllWeightToOneTest(t *testing.T) {
+	tests := []*models.TestCase{
+		{TestID: "a"},
+		{TestID: "b"},
+	}
+	picker := NewWeightedPicker(tests, map[string]float64{"a": 100, "b": 0.0001})
+	rng := rand.New(rand.NewSource(1))
+
+	counts := map[string]int{}
+	for i := 0; i < 200; i++ {
+		counts[picker.Pick(rng).TestID]++
+	}
+
+	if counts["a"] <= counts["b"] {
+		t.Fatalf("expected heavily-weighted test 'a' to be picked far more often, got %v", counts)
+	}
+}
+
+func TestWeightedPicker_DefaultsToWeightOne(t *testing.T) {
+	tests := []*models.TestCase{{TestID: "only"}}
+	picker := NewWeightedPicker(tests, nil)
+	rng := rand.New(rand.NewSource(1))
+
+	if got := picker.Pick(rng).TestID; got != "only" {
+		t.Fatalf("expected 'only', got %q", got)
+	}
+}
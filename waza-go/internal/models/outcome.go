@@ -20,6 +20,16 @@ type OutcomeSetup struct {
 	ModelID     string `json:"model_id"`
 	EngineType  string `json:"engine_type"`
 	TimeoutSec  int    `json:"timeout_sec"`
+	// Seed is the base seed used to shuffle the test list (when --randomize
+	// is set) and to derive each run's deterministic per-run seed, recorded
+	// so a flaky run can be reproduced exactly with "waza replay".
+	Seed int64 `json:"seed,omitempty"`
+	// ShardIndex and ShardTotal record the CI matrix shard that ran, if any.
+	ShardIndex int `json:"shard_index,omitempty"`
+	ShardTotal int `json:"shard_total,omitempty"`
+	// Iterations records how many times the benchmark was run due to
+	// --repeat or --until-fail.
+	Iterations int `json:"iterations,omitempty"`
 }
 
 type OutcomeDigest struct {
@@ -44,11 +54,12 @@ type MeasureResult struct {
 
 // TestOutcome represents the result of one test case
 type TestOutcome struct {
-	TestID      string      `json:"test_id"`
-	DisplayName string      `json:"display_name"`
-	Status      string      `json:"status"`
-	Runs        []RunResult `json:"runs"`
-	Stats       *TestStats  `json:"stats,omitempty"`
+	TestID        string      `json:"test_id"`
+	DisplayName   string      `json:"display_name"`
+	Status        string      `json:"status"`
+	Runs          []RunResult `json:"runs"`
+	Stats         *TestStats  `json:"stats,omitempty"`
+	SkippedReason string      `json:"skipped_reason,omitempty"`
 }
 
 // RunResult is the result of a single run/trial
@@ -61,6 +72,9 @@ type RunResult struct {
 	Transcript    []TranscriptEntry        `json:"transcript,omitempty"`
 	FinalOutput   string                   `json:"final_output"`
 	ErrorMsg      string                   `json:"error_msg,omitempty"`
+	// Seed is the deterministic per-run seed this run executed with,
+	// recorded so "waza replay" can force an engine to reproduce it exactly.
+	Seed int64 `json:"seed,omitempty"`
 }
 
 type ValidationOut struct {
@@ -90,6 +104,16 @@ type TranscriptEntry struct {
 	Data    map[string]any `json:"data,omitempty"`
 }
 
+// ToolCall is a tool invocation surfaced by the engine during a run, mirrored
+// from execution.ToolCall so graders can assert against it without the
+// scoring package importing execution.
+type ToolCall struct {
+	Name      string         `json:"name"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+	Result    any            `json:"result,omitempty"`
+	Success   bool           `json:"success"`
+}
+
 type TestStats struct {
 	PassRate      float64 `json:"pass_rate"`
 	AvgScore      float64 `json:"avg_score"`
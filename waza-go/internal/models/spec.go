@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spboyer/waza/waza-go/internal/schema"
 	"gopkg.in/yaml.v3"
 )
 
@@ -19,6 +20,10 @@ type BenchmarkSpec struct {
 	Graders      []GraderConfig   `yaml:"graders"`
 	Metrics      []MeasurementDef `yaml:"metrics"`
 	Tasks        []string         `yaml:"tasks"`
+	// Variables seeds TestRunner's vars for {{.var}} template interpolation
+	// in test stimuli, resource bodies and grader parameters. --var flags
+	// and Extraction-captured values from earlier tests take priority.
+	Variables map[string]any `yaml:"variables,omitempty" json:"variables,omitempty"`
 }
 
 type SpecIdentity struct {
@@ -35,8 +40,22 @@ type Config struct {
 	StopOnError   bool           `yaml:"fail_fast,omitempty" json:"stop_on_error,omitempty"`
 	EngineType    string         `yaml:"executor" json:"engine_type"`
 	ModelID       string         `yaml:"model" json:"model_id"`
+	// EngineParams is passed straight through to the selected AgentEngine's
+	// constructor (api_key, base_url, ...); each engine type documents the
+	// params it reads.
+	EngineParams  map[string]any `yaml:"engine_params,omitempty" json:"engine_params,omitempty"`
 	SkillPaths    []string       `yaml:"skill_directories,omitempty" json:"skill_paths,omitempty"`
 	ServerConfigs map[string]any `yaml:"mcp_servers,omitempty" json:"server_configs,omitempty"`
+	// AdapterCommand and AdapterArgs configure non-LLM execution.Adapters
+	// (gtest, pytest, exec) registered under the same name as EngineType.
+	// Unused when EngineType selects an AgentEngine instead.
+	AdapterCommand string   `yaml:"adapter_command,omitempty" json:"adapter_command,omitempty"`
+	AdapterArgs    []string `yaml:"adapter_args,omitempty" json:"adapter_args,omitempty"`
+	// Seed is the default base seed used to derive each run's deterministic
+	// per-run seed (see orchestration.TestRunner.executeRun) and, when
+	// --randomize is set without a --seed flag, to shuffle the test list.
+	// The --seed flag takes priority over this when both are set.
+	Seed int64 `yaml:"seed,omitempty" json:"seed,omitempty"`
 }
 
 // GraderConfig defines a validator/grader
@@ -78,6 +97,33 @@ func LoadBenchmarkSpec(path string) (*BenchmarkSpec, error) {
 	return &spec, nil
 }
 
+// LoadBenchmarkSpecStrict loads a spec from a YAML file and rejects it if it
+// doesn't conform to the embedded BenchmarkSpec JSON Schema: unknown fields,
+// a bad engine_type/grader kind enum, or a missing required field are all
+// reported together via a *schema.SpecValidationError instead of failing on
+// the first problem found.
+func LoadBenchmarkSpecStrict(path string) (*BenchmarkSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := schema.Validate("benchmark_spec", data); err != nil {
+		return nil, err
+	}
+
+	var spec BenchmarkSpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
 // Validate checks that the spec is valid
 func (s *BenchmarkSpec) Validate() error {
 	if s.Config.RunsPerTest < 1 {
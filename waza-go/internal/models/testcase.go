@@ -3,6 +3,7 @@ package models
 import (
 	"os"
 
+	"github.com/spboyer/waza/waza-go/internal/schema"
 	"gopkg.in/yaml.v3"
 )
 
@@ -18,6 +19,28 @@ type TestCase struct {
 	Active      *bool             `yaml:"enabled,omitempty" json:"active,omitempty"`
 	TimeoutSec  *int              `yaml:"timeout_seconds,omitempty" json:"timeout_sec,omitempty"`
 	ContextRoot string            `yaml:"context_dir,omitempty" json:"context_root,omitempty"`
+	// Extracts captures values from this test's run into TestRunner's vars
+	// for later tests to use via {{.var}} interpolation, e.g. a login test
+	// extracting a token a later test sends back. Only merged back when
+	// Config.Concurrent is false, since run order (and so which run "later"
+	// means) isn't defined otherwise.
+	Extracts []Extraction `yaml:"extracts,omitempty" json:"extracts,omitempty"`
+}
+
+// Extraction captures one named variable out of a test's FinalOutput (or
+// transcript) for subsequent tests to interpolate via {{.var}}.
+type Extraction struct {
+	Var string `yaml:"var" json:"var"`
+	// Regex captures the variable from the first subgroup of this pattern.
+	Regex string `yaml:"regex,omitempty" json:"regex,omitempty"`
+	// Path looks the variable up in the source parsed as JSON, following a
+	// dotted path (e.g. "token" or "auth.token"). This is a practical
+	// subset of JSONPath, not the full spec: no array indices or wildcards.
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+	// Source selects what text Regex/Path run against: "output" (the
+	// default, RunResult.FinalOutput) or "transcript" (every transcript
+	// entry's content, joined with newlines).
+	Source string `yaml:"from,omitempty" json:"source,omitempty"`
 }
 
 // TestStimulus defines the input for a test
@@ -85,3 +108,24 @@ func LoadTestCase(path string) (*TestCase, error) {
 
 	return &tc, nil
 }
+
+// LoadTestCaseStrict loads a test case from a YAML file and rejects it if it
+// doesn't conform to the embedded TestCase JSON Schema, the same way
+// LoadBenchmarkSpecStrict does for benchmark specs.
+func LoadTestCaseStrict(path string) (*TestCase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := schema.Validate("test_case", data); err != nil {
+		return nil, err
+	}
+
+	var tc TestCase
+	if err := yaml.Unmarshal(data, &tc); err != nil {
+		return nil, err
+	}
+
+	return &tc, nil
+}
@@ -0,0 +1,56 @@
+package orchestration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExplainTest_TracesGraderAssertions(t *testing.T) {
+	dir := t.TempDir()
+	content := `id: task-0
+name: Task 0
+inputs:
+  prompt: hello
+graders:
+  - name: mentions-hello
+    type: code
+    assertions:
+      - "contains(output, \"hello\")"
+`
+	if err := os.WriteFile(filepath.Join(dir, "task0.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write task file: %v", err)
+	}
+
+	runner := newTestRunner(t, dir)
+	traces, err := runner.ExplainTest(context.Background(), "task-0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(traces) != 1 {
+		t.Fatalf("expected 1 trace, got %d", len(traces))
+	}
+	if traces[0].Identifier != "mentions-hello" {
+		t.Fatalf("expected trace for mentions-hello, got %q", traces[0].Identifier)
+	}
+	if !traces[0].Passed {
+		t.Fatalf("expected the assertion to pass against the mock engine's echoed output, got %+v", traces[0])
+	}
+}
+
+func TestExplainTest_UnknownTestID(t *testing.T) {
+	dir := t.TempDir()
+	writeTestSpec(t, dir, "task0.yaml")
+
+	runner := newTestRunner(t, dir)
+	_, err := runner.ExplainTest(context.Background(), "does-not-exist")
+	if err == nil {
+		t.Fatalf("expected an error for an unknown test id")
+	}
+	if !strings.Contains(err.Error(), "does-not-exist") {
+		t.Fatalf("expected error to name the missing id, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package orchestration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/config"
+	"github.com/spboyer/waza/waza-go/internal/filter"
+)
+
+func writeLabeledTask(t *testing.T, dir, name, id string, labels ...string) {
+	t.Helper()
+	content := "id: " + id + "\nname: " + id + "\ninputs:\n  prompt: hello\n"
+	if len(labels) > 0 {
+		content += "tags:\n"
+		for _, l := range labels {
+			content += "  - " + l + "\n"
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write task file: %v", err)
+	}
+}
+
+func TestRunBenchmark_AppliesSkipFilter(t *testing.T) {
+	dir := t.TempDir()
+	writeLabeledTask(t, dir, "task0.yaml", "task-0")
+	writeLabeledTask(t, dir, "task1.yaml", "task-1")
+
+	runner := newTestRunner(t, dir, config.WithFilter(filter.Opts{Skip: "task-1"}))
+	outcome, err := runner.RunBenchmark(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ran, skipped int
+	for _, to := range outcome.TestOutcomes {
+		if to.Status == "skipped" {
+			skipped++
+		} else {
+			ran++
+		}
+	}
+	if ran != 1 || skipped != 1 {
+		t.Fatalf("expected 1 run and 1 skipped outcome, got ran=%d skipped=%d", ran, skipped)
+	}
+}
+
+func TestRunBenchmark_AppliesLabelFilter(t *testing.T) {
+	dir := t.TempDir()
+	writeLabeledTask(t, dir, "task0.yaml", "task-0", "smoke")
+	writeLabeledTask(t, dir, "task1.yaml", "task-1", "slow")
+
+	runner := newTestRunner(t, dir, config.WithFilter(filter.Opts{Labels: "smoke"}))
+	outcome, err := runner.RunBenchmark(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ranIDs []string
+	for _, to := range outcome.TestOutcomes {
+		if to.Status != "skipped" {
+			ranIDs = append(ranIDs, to.TestID)
+		}
+	}
+	if len(ranIDs) != 1 || ranIDs[0] != "task-0" {
+		t.Fatalf("expected only task-0 to run, got %v", ranIDs)
+	}
+}
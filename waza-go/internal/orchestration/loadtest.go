@@ -0,0 +1,154 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/spboyer/waza/waza-go/internal/loadtest"
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// tickInterval is how often EventLoadTestTick fires while a strategy runs.
+const tickInterval = time.Second
+
+// LoadTestReport collects every strategy's metrics from one load test plan.
+type LoadTestReport struct {
+	PlanName   string                     `json:"plan_name"`
+	Strategies []loadtest.StrategyMetrics `json:"strategies"`
+}
+
+// RunLoadTest drives engine.Execute under a loadtest.Plan: each strategy
+// spawns Concurrency virtual users that repeatedly pick a weighted-random
+// test case and execute+grade it (reusing the same executeRun path as
+// RunBenchmark), capped by a token-bucket rate limiter, until the
+// strategy's Duration elapses or Iterations requests have been dispatched.
+// Strategies run one after another; progress is reported incrementally via
+// EventLoadTestTick and, once a strategy finishes, EventStrategyComplete.
+func (r *TestRunner) RunLoadTest(ctx context.Context, plan *loadtest.Plan) (*LoadTestReport, error) {
+	if err := r.engine.Initialize(ctx); err != nil {
+		return nil, fmt.Errorf("failed to initialize engine: %w", err)
+	}
+	defer func() {
+		if err := r.engine.Shutdown(ctx); err != nil {
+			fmt.Printf("warning: failed to shutdown engine: %v\n", err)
+		}
+	}()
+
+	testCases, err := r.loadTestCases()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load test cases: %w", err)
+	}
+	if len(testCases) == 0 {
+		return nil, fmt.Errorf("no test cases found")
+	}
+
+	report := &LoadTestReport{PlanName: plan.Name}
+
+	for _, strategy := range plan.Strategies {
+		metrics := r.runStrategy(ctx, strategy, testCases)
+		report.Strategies = append(report.Strategies, metrics)
+
+		r.notifyProgress(ProgressEvent{
+			EventType: EventStrategyComplete,
+			TestName:  strategy.Name,
+			Details:   map[string]any{"metrics": metrics},
+		})
+	}
+
+	return report, nil
+}
+
+// runStrategy drives one strategy to completion and returns its reduced
+// metrics.
+func (r *TestRunner) runStrategy(ctx context.Context, strategy loadtest.Strategy, testCases []*models.TestCase) loadtest.StrategyMetrics {
+	picker := loadtest.NewWeightedPicker(testCases, strategy.Weights)
+	limiter := loadtest.NewRateLimiter(strategy.RatePerSec)
+
+	strategyCtx := ctx
+	if strategy.Duration > 0 {
+		var cancel context.CancelFunc
+		strategyCtx, cancel = context.WithTimeout(ctx, strategy.Duration)
+		defer cancel()
+	}
+
+	concurrency := strategy.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	maxIterations := int64(strategy.Iterations)
+
+	var (
+		mu      sync.Mutex
+		samples []loadtest.Sample
+	)
+	var dispatched int64
+
+	start := time.Now()
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	tickDone := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				mu.Lock()
+				n := len(samples)
+				mu.Unlock()
+				r.notifyProgress(ProgressEvent{
+					EventType: EventLoadTestTick,
+					TestName:  strategy.Name,
+					Details:   map[string]any{"requests_so_far": n, "elapsed_ms": time.Since(start).Milliseconds()},
+				})
+			case <-tickDone:
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(workerSeed int64) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(workerSeed))
+
+			for {
+				if maxIterations > 0 && atomic.AddInt64(&dispatched, 1) > maxIterations {
+					return
+				}
+
+				select {
+				case <-strategyCtx.Done():
+					return
+				default:
+				}
+
+				if err := limiter.Wait(strategyCtx); err != nil {
+					return
+				}
+
+				tc := picker.Pick(rng)
+				reqStart := time.Now()
+				run := r.executeRun(strategyCtx, tc, 0)
+
+				mu.Lock()
+				samples = append(samples, loadtest.Sample{
+					LatencyMs: time.Since(reqStart).Milliseconds(),
+					ToolCalls: len(run.SessionDigest.ToolsUsed),
+					Passed:    run.Status == "passed",
+					Errored:   run.Status == "error",
+				})
+				mu.Unlock()
+			}
+		}(time.Now().UnixNano() + int64(i))
+	}
+
+	wg.Wait()
+	close(tickDone)
+
+	return loadtest.Reduce(strategy.Name, samples, time.Since(start))
+}
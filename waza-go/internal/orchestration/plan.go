@@ -0,0 +1,139 @@
+package orchestration
+
+import (
+	"path/filepath"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+	"github.com/spboyer/waza/waza-go/internal/scoring"
+)
+
+// PlanVersion is the schema version stamped on every Plan. Bump it whenever
+// a field is removed or changes meaning so downstream tools (shard planners,
+// cost estimators, dashboards) can detect incompatible changes.
+const PlanVersion = 1
+
+// Plan is the resolved set of tests a benchmark run would execute, without
+// actually executing anything. It's what `waza list` and `run --dry-run`
+// print.
+type Plan struct {
+	Version   int         `json:"version"`
+	SpecName  string      `json:"spec_name"`
+	Tests     []PlanEntry `json:"tests"`
+	TotalRuns int         `json:"total_runs"`
+}
+
+// PlanEntry describes one test case's place in the plan.
+type PlanEntry struct {
+	TestID        string   `json:"test_id"`
+	DisplayName   string   `json:"display_name"`
+	Labels        []string `json:"labels,omitempty"`
+	Runs          int      `json:"runs"`
+	TimeoutSec    int      `json:"timeout_sec"`
+	Skipped       bool     `json:"skipped,omitempty"`
+	SkippedReason string   `json:"skipped_reason,omitempty"`
+	// Graders lists the identifier of every grader (spec-level and
+	// per-test) that would score this test, instantiated from its
+	// configured kind. An unknown kind is not reported: it is instantiated
+	// as a no-op validator and listed like any other.
+	Graders []string `json:"graders,omitempty"`
+	// ResolvedFixtures lists the on-disk path each of this test's file
+	// resources would be loaded from.
+	ResolvedFixtures []string `json:"resolved_fixtures,omitempty"`
+}
+
+// Plan resolves the test list the same way RunBenchmark would — loading
+// test cases, applying --focus/--skip/--labels filtering, and
+// --randomize/--seed/--shard — without dispatching any runs. The result
+// mirrors exactly which tests RunBenchmark would execute or skip.
+func (r *TestRunner) Plan() (*Plan, error) {
+	spec := r.cfg.Spec()
+
+	testCases, err := r.loadTestCases()
+	if err != nil {
+		return nil, err
+	}
+
+	kept, skippedOutcomes, err := r.applyFilter(testCases)
+	if err != nil {
+		return nil, err
+	}
+
+	kept, _ = r.applyRunPlan(kept)
+
+	plan := &Plan{
+		Version:  PlanVersion,
+		SpecName: spec.Name,
+	}
+
+	for _, tc := range kept {
+		timeout := spec.Config.TimeoutSec
+		if tc.TimeoutSec != nil {
+			timeout = *tc.TimeoutSec
+		}
+
+		plan.Tests = append(plan.Tests, PlanEntry{
+			TestID:           tc.TestID,
+			DisplayName:      tc.DisplayName,
+			Labels:           tc.Labels,
+			Runs:             spec.Config.RunsPerTest,
+			TimeoutSec:       timeout,
+			Graders:          r.plannedGraders(tc),
+			ResolvedFixtures: r.resolvedFixtures(tc),
+		})
+		plan.TotalRuns += spec.Config.RunsPerTest
+	}
+
+	for _, outcome := range skippedOutcomes {
+		plan.Tests = append(plan.Tests, PlanEntry{
+			TestID:        outcome.TestID,
+			DisplayName:   outcome.DisplayName,
+			Skipped:       true,
+			SkippedReason: outcome.SkippedReason,
+		})
+	}
+
+	return plan, nil
+}
+
+// plannedGraders instantiates every grader that would score tc — the
+// spec-level graders plus tc's own inline validators — the same way
+// runValidators does, but only to collect their identifiers.
+func (r *TestRunner) plannedGraders(tc *models.TestCase) []string {
+	spec := r.cfg.Spec()
+	var graders []string
+
+	for _, vCfg := range spec.Graders {
+		validator := scoring.CreateValidator(vCfg.Kind, vCfg.Identifier, vCfg.Parameters)
+		graders = append(graders, validator.Identifier())
+	}
+
+	for _, vCfg := range tc.Validators {
+		if vCfg.Kind == "" {
+			continue
+		}
+		validator := scoring.CreateValidator(vCfg.Kind, vCfg.Identifier, vCfg.Parameters)
+		graders = append(graders, validator.Identifier())
+	}
+
+	return graders
+}
+
+// resolvedFixtures lists the on-disk path each of tc's file resources would
+// be loaded from, mirroring loadResources' directory resolution without
+// reading file contents.
+func (r *TestRunner) resolvedFixtures(tc *models.TestCase) []string {
+	fixtureDir := r.cfg.FixtureDir()
+	if tc.ContextRoot != "" {
+		fixtureDir = tc.ContextRoot
+	}
+
+	var fixtures []string
+	for _, ref := range tc.Stimulus.Resources {
+		if ref.Body != "" || ref.Location == "" || fixtureDir == "" {
+			continue
+		}
+		fixtures = append(fixtures, filepath.Join(fixtureDir, filepath.Clean(ref.Location)))
+	}
+
+	return fixtures
+}
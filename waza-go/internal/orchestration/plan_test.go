@@ -0,0 +1,63 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/config"
+)
+
+func TestPlan_ListsRunsWithoutSkips(t *testing.T) {
+	dir := t.TempDir()
+	writeTestSpec(t, dir, "task0.yaml", "task1.yaml")
+
+	runner := newTestRunner(t, dir)
+	plan, err := runner.Plan()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if plan.Version != PlanVersion {
+		t.Fatalf("expected plan version %d, got %d", PlanVersion, plan.Version)
+	}
+	if len(plan.Tests) != 2 {
+		t.Fatalf("expected 2 planned tests, got %d", len(plan.Tests))
+	}
+	if plan.TotalRuns != 2 {
+		t.Fatalf("expected 2 total runs, got %d", plan.TotalRuns)
+	}
+	for _, entry := range plan.Tests {
+		if entry.Skipped {
+			t.Fatalf("did not expect any skipped entries, got %+v", entry)
+		}
+	}
+}
+
+func TestPlan_ResolvesFixturePaths(t *testing.T) {
+	dir := t.TempDir()
+	content := `id: task-0
+name: Task 0
+inputs:
+  prompt: hello
+  files:
+    - path: input.txt
+`
+	if err := os.WriteFile(filepath.Join(dir, "task0.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write task file: %v", err)
+	}
+
+	runner := newTestRunner(t, dir, config.WithFixtureDir(dir))
+	plan, err := runner.Plan()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(plan.Tests) != 1 {
+		t.Fatalf("expected 1 planned test, got %d", len(plan.Tests))
+	}
+	want := filepath.Join(dir, "input.txt")
+	if len(plan.Tests[0].ResolvedFixtures) != 1 || plan.Tests[0].ResolvedFixtures[0] != want {
+		t.Fatalf("expected resolved fixture %q, got %v", want, plan.Tests[0].ResolvedFixtures)
+	}
+}
@@ -0,0 +1,1039 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spboyer/waza/waza-go/internal/config"
+	"github.com/spboyer/waza/waza-go/internal/execution"
+	"github.com/spboyer/waza/waza-go/internal/filter"
+	"github.com/spboyer/waza/waza-go/internal/models"
+	"github.com/spboyer/waza/waza-go/internal/scoring"
+)
+
+// TestRunner orchestrates the execution of tests
+type TestRunner struct {
+	cfg     *config.BenchmarkConfig
+	engine  execution.AgentEngine
+	adapter execution.Adapter
+	verbose bool
+
+	// Progress tracking
+	progressMu sync.Mutex
+	listeners  []ProgressListener
+
+	// Outcome tracking
+	outcomeMu        sync.Mutex
+	outcomeListeners []OutcomeListener
+
+	// seed is the base seed resolved by applyRunPlan, used to derive each
+	// run's deterministic seed (see deriveRunSeed).
+	seed int64
+	// seedOverrides forces a specific test's per-run seed instead of the
+	// derived one, so "waza replay" can reproduce an exact recorded run.
+	seedOverrides map[string]int64
+
+	// Variable substitution: vars is seeded from the spec's own Variables
+	// and --var flags, then grows as sequential tests' Extracts are merged
+	// back in (see runSequential).
+	varsMu sync.RWMutex
+	vars   map[string]any
+}
+
+// currentVars returns a snapshot of the runner's vars for interpolation.
+func (r *TestRunner) currentVars() map[string]any {
+	r.varsMu.RLock()
+	defer r.varsMu.RUnlock()
+	return mergeVars(r.vars, nil)
+}
+
+// applyExtracts merges a completed run's Extracts into vars, so later
+// sequential tests can interpolate what this one captured.
+func (r *TestRunner) applyExtracts(tc *models.TestCase, run models.RunResult) {
+	if len(tc.Extracts) == 0 {
+		return
+	}
+
+	r.varsMu.Lock()
+	defer r.varsMu.Unlock()
+	if r.vars == nil {
+		r.vars = make(map[string]any)
+	}
+	for _, ext := range tc.Extracts {
+		if value, ok := extractValue(ext, run); ok {
+			r.vars[ext.Var] = value
+		}
+	}
+}
+
+// OverrideSeed forces testID's runs to use seed instead of the one derived
+// from the runner's base seed, so a recorded failing run can be replayed
+// exactly by "waza replay".
+func (r *TestRunner) OverrideSeed(testID string, seed int64) {
+	if r.seedOverrides == nil {
+		r.seedOverrides = make(map[string]int64)
+	}
+	r.seedOverrides[testID] = seed
+}
+
+// deriveRunSeed returns a deterministic per-run seed from the benchmark's
+// base seed, the test ID and the run number. Hashing rather than drawing
+// sequentially from a shared *rand.Rand keeps a given test/run's seed
+// independent of the order runConcurrent happens to execute runs in.
+func deriveRunSeed(base int64, testID string, runNum int) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d:%s:%d", base, testID, runNum)
+	return int64(h.Sum64())
+}
+
+// ProgressListener receives progress updates
+type ProgressListener func(event ProgressEvent)
+
+// OutcomeListener receives a test's outcome as soon as it finishes, letting
+// callers (e.g. streaming JSONL output) flush results incrementally instead
+// of waiting for the whole benchmark to complete.
+type OutcomeListener func(outcome models.TestOutcome)
+
+// EventType represents the type of progress event
+type EventType string
+
+// EventType constants
+const (
+	EventBenchmarkStart    EventType = "benchmark_start"
+	EventBenchmarkComplete EventType = "benchmark_complete"
+	EventBenchmarkStopped  EventType = "benchmark_stopped"
+	EventTestStart         EventType = "test_start"
+	EventTestComplete      EventType = "test_complete"
+	EventRunStart          EventType = "run_start"
+	EventRunComplete       EventType = "run_complete"
+	EventTestSkipped       EventType = "test_skipped"
+	EventLoadTestTick      EventType = "loadtest_tick"
+	EventStrategyComplete  EventType = "strategy_complete"
+)
+
+// ProgressEvent represents a progress update
+type ProgressEvent struct {
+	EventType  EventType
+	TestName   string
+	TestNum    int
+	TotalTests int
+	RunNum     int
+	TotalRuns  int
+	Status     string
+	DurationMs int64
+	Details    map[string]any
+}
+
+// NewTestRunner creates a new test runner
+func NewTestRunner(cfg *config.BenchmarkConfig, engine execution.AgentEngine) *TestRunner {
+	return &TestRunner{
+		cfg:       cfg,
+		engine:    engine,
+		verbose:   cfg.Verbose(),
+		listeners: []ProgressListener{},
+	}
+}
+
+// UseAdapter switches the runner from its AgentEngine to a non-LLM
+// execution.Adapter (gtest, pytest, exec, ...): RunBenchmark then calls
+// adapter.Build/Evaluate instead of engine.Initialize/Execute, skipping the
+// grading pipeline entirely since the adapter reports its own Validations.
+func (r *TestRunner) UseAdapter(adapter execution.Adapter) {
+	r.adapter = adapter
+}
+
+// OnProgress registers a progress listener
+func (r *TestRunner) OnProgress(listener ProgressListener) {
+	r.progressMu.Lock()
+	defer r.progressMu.Unlock()
+	r.listeners = append(r.listeners, listener)
+}
+
+// OnTestOutcome registers a listener invoked with each test's outcome as
+// soon as it finishes, before the rest of the benchmark has run.
+func (r *TestRunner) OnTestOutcome(listener OutcomeListener) {
+	r.outcomeMu.Lock()
+	defer r.outcomeMu.Unlock()
+	r.outcomeListeners = append(r.outcomeListeners, listener)
+}
+
+func (r *TestRunner) notifyOutcome(outcome models.TestOutcome) {
+	r.outcomeMu.Lock()
+	listeners := make([]OutcomeListener, len(r.outcomeListeners))
+	copy(listeners, r.outcomeListeners)
+	r.outcomeMu.Unlock()
+
+	for _, listener := range listeners {
+		listener(outcome)
+	}
+}
+
+func (r *TestRunner) notifyProgress(event ProgressEvent) {
+	r.progressMu.Lock()
+	listeners := make([]ProgressListener, len(r.listeners))
+	copy(listeners, r.listeners)
+	r.progressMu.Unlock()
+
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// RunBenchmark executes the benchmark, honoring the configured RunPlan:
+// --repeat reruns it N times aggregating a combined outcome, and
+// --until-fail reruns it until a test fails/errors or --max-duration is hit.
+func (r *TestRunner) RunBenchmark(ctx context.Context) (*models.EvaluationOutcome, error) {
+	// Load test cases
+	testCases, err := r.loadTestCases()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load test cases: %w", err)
+	}
+
+	if len(testCases) == 0 {
+		return nil, fmt.Errorf("no test cases found")
+	}
+
+	// Apply --focus/--skip/--labels filtering before dispatching any runs.
+	testCases, skippedOutcomes, err := r.applyFilter(testCases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply test filter: %w", err)
+	}
+
+	// Apply --randomize/--seed shuffling and --shard splitting.
+	testCases, seed := r.applyRunPlan(testCases)
+	r.seed = seed
+
+	// Seed vars from the spec's own Variables, then layer --var flags on
+	// top; Extracts from sequential tests merge in as they complete.
+	r.vars = mergeVars(r.cfg.Spec().Variables, r.cfg.Vars())
+
+	// Prepare the execution backend: a non-LLM Adapter if one was selected
+	// via UseAdapter, otherwise the usual AgentEngine.
+	if r.adapter != nil {
+		if err := r.adapter.Build(testCases); err != nil {
+			return nil, fmt.Errorf("failed to build adapter: %w", err)
+		}
+	} else {
+		if err := r.engine.Initialize(ctx); err != nil {
+			return nil, fmt.Errorf("failed to initialize engine: %w", err)
+		}
+		defer func() {
+			if err := r.engine.Shutdown(ctx); err != nil {
+				fmt.Printf("warning: failed to shutdown engine: %v\n", err)
+			}
+		}()
+	}
+
+	plan := r.cfg.RunPlan()
+	repeat := plan.Repeat
+	if repeat < 1 {
+		repeat = 1
+	}
+	multiIteration := repeat > 1 || plan.UntilFail
+
+	overallStart := time.Now()
+	var combined *models.EvaluationOutcome
+	iterations := 0
+
+	for {
+		outcome := r.runOnce(ctx, testCases, skippedOutcomes)
+		iterations++
+		combined = r.mergeOutcome(combined, outcome, iterations, multiIteration)
+
+		failed := outcome.Digest.Failed > 0 || outcome.Digest.Errors > 0
+		if plan.UntilFail {
+			if failed {
+				break
+			}
+			if plan.MaxDuration > 0 && time.Since(overallStart) >= plan.MaxDuration {
+				break
+			}
+			continue
+		}
+		if iterations >= repeat {
+			break
+		}
+	}
+
+	combined.Setup.Seed = seed
+	combined.Setup.ShardIndex = plan.ShardIndex
+	combined.Setup.ShardTotal = plan.ShardTotal
+	combined.Setup.Iterations = iterations
+
+	return combined, nil
+}
+
+// runOnce executes every test case exactly once and returns its outcome.
+func (r *TestRunner) runOnce(ctx context.Context, testCases []*models.TestCase, skippedOutcomes []models.TestOutcome) *models.EvaluationOutcome {
+	startTime := time.Now()
+
+	r.notifyProgress(ProgressEvent{
+		EventType:  EventBenchmarkStart,
+		TotalTests: len(testCases),
+	})
+
+	spec := r.cfg.Spec()
+
+	var testOutcomes []models.TestOutcome
+	if spec.Config.Concurrent {
+		testOutcomes = r.runConcurrent(ctx, testCases)
+	} else {
+		testOutcomes = r.runSequential(ctx, testCases)
+	}
+	testOutcomes = append(testOutcomes, skippedOutcomes...)
+
+	outcome := r.buildOutcome(testOutcomes, startTime)
+
+	r.notifyProgress(ProgressEvent{
+		EventType:  EventBenchmarkComplete,
+		DurationMs: time.Since(startTime).Milliseconds(),
+	})
+
+	return outcome
+}
+
+// mergeOutcome folds one iteration's outcome into the running combined
+// outcome. When tag is true (--repeat is above 1 or --until-fail is set,
+// even if only one iteration ends up running), each test's ID and display
+// name are suffixed with the iteration number so results stay
+// distinguishable.
+func (r *TestRunner) mergeOutcome(combined, outcome *models.EvaluationOutcome, iteration int, tag bool) *models.EvaluationOutcome {
+	if tag {
+		for i := range outcome.TestOutcomes {
+			outcome.TestOutcomes[i].TestID = fmt.Sprintf("%s#%d", outcome.TestOutcomes[i].TestID, iteration)
+			outcome.TestOutcomes[i].DisplayName = fmt.Sprintf("%s (iteration %d)", outcome.TestOutcomes[i].DisplayName, iteration)
+		}
+	}
+
+	if combined == nil {
+		return outcome
+	}
+
+	combined.TestOutcomes = append(combined.TestOutcomes, outcome.TestOutcomes...)
+	combined.Digest.TotalTests += outcome.Digest.TotalTests
+	combined.Digest.Succeeded += outcome.Digest.Succeeded
+	combined.Digest.Failed += outcome.Digest.Failed
+	combined.Digest.Errors += outcome.Digest.Errors
+	combined.Digest.Skipped += outcome.Digest.Skipped
+	combined.Digest.DurationMs += outcome.Digest.DurationMs
+
+	executed := combined.Digest.TotalTests - combined.Digest.Skipped
+	if executed > 0 {
+		combined.Digest.SuccessRate = float64(combined.Digest.Succeeded) / float64(executed)
+	}
+	combined.Digest.AggregateScore = r.computeAggregateScore(combined.TestOutcomes)
+
+	return combined
+}
+
+// applyRunPlan shuffles the test list deterministically (seeded by
+// resolveSeed) when --randomize is set, then splits it across shards when
+// --shard was given. It returns the seed that was actually used so it can
+// be recorded on the outcome for reproduction and used to derive each run's
+// per-run seed even when --randomize wasn't set.
+func (r *TestRunner) applyRunPlan(testCases []*models.TestCase) ([]*models.TestCase, int64) {
+	plan := r.cfg.RunPlan()
+	seed := r.resolveSeed()
+
+	if plan.Randomize {
+		rng := rand.New(rand.NewSource(seed))
+		rng.Shuffle(len(testCases), func(i, j int) {
+			testCases[i], testCases[j] = testCases[j], testCases[i]
+		})
+	}
+
+	if plan.ShardTotal > 1 {
+		shard := make([]*models.TestCase, 0, len(testCases)/plan.ShardTotal+1)
+		for i, tc := range testCases {
+			if i%plan.ShardTotal == plan.ShardIndex {
+				shard = append(shard, tc)
+			}
+		}
+		testCases = shard
+	}
+
+	return testCases, seed
+}
+
+// resolveSeed picks the base seed used both to shuffle the test list (when
+// --randomize is set) and to derive each run's deterministic per-run seed:
+// the --seed flag, then the spec's config.seed, then the current time if
+// neither was set.
+func (r *TestRunner) resolveSeed() int64 {
+	if seed := r.cfg.RunPlan().Seed; seed != 0 {
+		return seed
+	}
+	if seed := r.cfg.Spec().Config.Seed; seed != 0 {
+		return seed
+	}
+	return time.Now().UnixNano()
+}
+
+func (r *TestRunner) loadTestCases() ([]*models.TestCase, error) {
+	spec := r.cfg.Spec()
+
+	// Get base directory for test file resolution (spec directory)
+	baseDir := r.cfg.SpecDir()
+	if baseDir == "" {
+		baseDir = "."
+	}
+
+	// Resolve test file patterns relative to the spec directory
+	testFiles := []string{}
+	for _, pattern := range spec.Tasks {
+		fullPattern := filepath.Join(baseDir, pattern)
+		matches, err := filepath.Glob(fullPattern)
+		if err != nil {
+			return nil, err
+		}
+		testFiles = append(testFiles, matches...)
+	}
+
+	if len(testFiles) == 0 {
+		return nil, fmt.Errorf("no test files matched patterns: %v in directory: %s", spec.Tasks, baseDir)
+	}
+
+	var testCases []*models.TestCase
+	for _, path := range testFiles {
+		tc, err := models.LoadTestCase(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load test case %s: %w", path, err)
+		}
+		// LoadTestCase leaves Active nil when unset; treat nil as enabled.
+		if tc.Active == nil || *tc.Active {
+			testCases = append(testCases, tc)
+		}
+	}
+
+	return testCases, nil
+}
+
+// applyFilter narrows testCases down to the subset selected by the
+// configured --focus/--skip/--labels options (falling back to the
+// WAZA_FOCUS/WAZA_LABELS environment variables when a flag wasn't set), and
+// turns every excluded test into a "skipped" outcome so reporters can see
+// why it didn't run.
+func (r *TestRunner) applyFilter(testCases []*models.TestCase) ([]*models.TestCase, []models.TestOutcome, error) {
+	opts := r.cfg.Filter()
+	if opts.Focus == "" {
+		opts.Focus = os.Getenv("WAZA_FOCUS")
+	}
+	if opts.Labels == "" {
+		opts.Labels = os.Getenv("WAZA_LABELS")
+	}
+
+	kept, skipped, err := filter.Filter(testCases, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outcomes := make([]models.TestOutcome, 0, len(skipped))
+	for _, s := range skipped {
+		r.notifyProgress(ProgressEvent{
+			EventType: EventTestSkipped,
+			TestName:  s.TestCase.DisplayName,
+			Status:    "skipped",
+			Details:   map[string]any{"reason": s.Reason},
+		})
+		outcomes = append(outcomes, models.TestOutcome{
+			TestID:        s.TestCase.TestID,
+			DisplayName:   s.TestCase.DisplayName,
+			Status:        "skipped",
+			SkippedReason: s.Reason,
+		})
+	}
+
+	return kept, outcomes, nil
+}
+
+func (r *TestRunner) runSequential(ctx context.Context, testCases []*models.TestCase) []models.TestOutcome {
+	outcomes := make([]models.TestOutcome, 0, len(testCases))
+	spec := r.cfg.Spec()
+
+	for i, tc := range testCases {
+		if spec.Config.StopOnError && i > 0 {
+			for _, prevResult := range outcomes {
+				if prevResult.Status != "passed" {
+					r.notifyProgress(ProgressEvent{
+						EventType: EventBenchmarkStopped,
+						Details:   map[string]any{"reason": "fail_fast enabled and previous test failed"},
+					})
+					return outcomes
+				}
+			}
+		}
+
+		r.notifyProgress(ProgressEvent{
+			EventType:  EventTestStart,
+			TestName:   tc.DisplayName,
+			TestNum:    i + 1,
+			TotalTests: len(testCases),
+		})
+
+		outcome := r.runTest(ctx, tc, i+1, len(testCases))
+		outcomes = append(outcomes, outcome)
+		r.notifyOutcome(outcome)
+
+		// Merge this test's Extracts into vars for later sequential tests.
+		// runConcurrent never calls this, since run order (and so which
+		// test is "later") isn't defined there.
+		if len(outcome.Runs) > 0 {
+			r.applyExtracts(tc, outcome.Runs[len(outcome.Runs)-1])
+		}
+
+		r.notifyProgress(ProgressEvent{
+			EventType:  EventTestComplete,
+			TestName:   tc.DisplayName,
+			TestNum:    i + 1,
+			TotalTests: len(testCases),
+			Status:     outcome.Status,
+		})
+	}
+
+	return outcomes
+}
+
+func (r *TestRunner) runConcurrent(ctx context.Context, testCases []*models.TestCase) []models.TestOutcome {
+	spec := r.cfg.Spec()
+	workers := spec.Config.Workers
+	if workers <= 0 {
+		workers = 4
+	}
+
+	type result struct {
+		index   int
+		outcome models.TestOutcome
+	}
+
+	resultChan := make(chan result, len(testCases))
+	semaphore := make(chan struct{}, workers)
+
+	var wg sync.WaitGroup
+
+	for i, tc := range testCases {
+		wg.Add(1)
+		go func(idx int, test *models.TestCase) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			r.notifyProgress(ProgressEvent{
+				EventType:  EventTestStart,
+				TestName:   test.DisplayName,
+				TestNum:    idx + 1,
+				TotalTests: len(testCases),
+			})
+
+			outcome := r.runTest(ctx, test, idx+1, len(testCases))
+			resultChan <- result{index: idx, outcome: outcome}
+			r.notifyOutcome(outcome)
+
+			r.notifyProgress(ProgressEvent{
+				EventType:  EventTestComplete,
+				TestName:   test.DisplayName,
+				TestNum:    idx + 1,
+				TotalTests: len(testCases),
+				Status:     outcome.Status,
+			})
+		}(i, tc)
+	}
+
+	go func() {
+		wg.Wait()
+		close(resultChan)
+	}()
+
+	results := make([]models.TestOutcome, len(testCases))
+	for res := range resultChan {
+		results[res.index] = res.outcome
+	}
+
+	return results
+}
+
+func (r *TestRunner) runTest(ctx context.Context, tc *models.TestCase, testNum, totalTests int) models.TestOutcome {
+	spec := r.cfg.Spec()
+	runsPerTest := spec.Config.RunsPerTest
+
+	runs := make([]models.RunResult, 0, runsPerTest)
+
+	for runNum := 1; runNum <= runsPerTest; runNum++ {
+		r.notifyProgress(ProgressEvent{
+			EventType:  EventRunStart,
+			TestName:   tc.DisplayName,
+			TestNum:    testNum,
+			TotalTests: totalTests,
+			RunNum:     runNum,
+			TotalRuns:  runsPerTest,
+		})
+
+		run := r.executeRun(ctx, tc, runNum)
+		runs = append(runs, run)
+
+		r.notifyProgress(ProgressEvent{
+			EventType:  EventRunComplete,
+			TestName:   tc.DisplayName,
+			TestNum:    testNum,
+			TotalTests: totalTests,
+			RunNum:     runNum,
+			TotalRuns:  runsPerTest,
+			Status:     run.Status,
+			DurationMs: run.DurationMs,
+		})
+	}
+
+	stats := r.computeTestStats(runs)
+
+	status := "passed"
+	for _, run := range runs {
+		if run.Status != "passed" {
+			status = "failed"
+			break
+		}
+	}
+
+	return models.TestOutcome{
+		TestID:      tc.TestID,
+		DisplayName: tc.DisplayName,
+		Status:      status,
+		Runs:        runs,
+		Stats:       stats,
+	}
+}
+
+// ExplainTest runs testID's stimulus once through the engine, the same way
+// executeRun does, but returns a step-by-step ValidationTrace per grader
+// instead of a RunResult. It's what `waza explain` is built on: seeing
+// which individual assertion or pattern failed, not just the pass/fail
+// verdict executeRun reports.
+func (r *TestRunner) ExplainTest(ctx context.Context, testID string) ([]*scoring.ValidationTrace, error) {
+	testCases, err := r.loadTestCases()
+	if err != nil {
+		return nil, err
+	}
+
+	var tc *models.TestCase
+	for _, candidate := range testCases {
+		if candidate.TestID == testID {
+			tc = candidate
+			break
+		}
+	}
+	if tc == nil {
+		return nil, fmt.Errorf("no test with id %q found", testID)
+	}
+
+	if r.adapter != nil {
+		return nil, fmt.Errorf("explain does not support adapter-backed tests yet")
+	}
+
+	// Seed vars the same way RunBenchmark does so the stimulus and grader
+	// parameters are interpolated as they would be in a real run.
+	r.vars = mergeVars(r.cfg.Spec().Variables, r.cfg.Vars())
+
+	req := r.buildExecutionRequest(tc)
+	req.Seed = deriveRunSeed(r.seed, tc.TestID, 1)
+	if override, ok := r.seedOverrides[tc.TestID]; ok {
+		req.Seed = override
+	}
+
+	resp, err := r.engine.Execute(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute test: %w", err)
+	}
+
+	vCtx := r.buildValidationContext(tc, resp)
+	return r.explainValidators(tc, vCtx)
+}
+
+// resolveValidators builds the full set of validators that apply to tc: the
+// spec-level graders followed by the test case's own validators, with
+// parameters interpolated against the runner's current vars. runValidators
+// and explainValidators both run this same resolved set, just applying a
+// different scoring.Validator method to each.
+func (r *TestRunner) resolveValidators(tc *models.TestCase) ([]scoring.Validator, error) {
+	vars := r.currentVars()
+	var validators []scoring.Validator
+
+	spec := r.cfg.Spec()
+	for _, vCfg := range spec.Graders {
+		validators = append(validators, scoring.CreateValidator(vCfg.Kind, vCfg.Identifier, interpolateParams(vCfg.Parameters, vars)))
+	}
+
+	for _, vCfg := range tc.Validators {
+		if vCfg.Kind == "" {
+			return nil, fmt.Errorf("no kind associated with grader %s", vCfg.Identifier)
+		}
+
+		params := vCfg.Parameters
+		if params == nil {
+			params = make(map[string]any)
+		}
+		if len(vCfg.Checks) > 0 {
+			params["assertions"] = vCfg.Checks
+		}
+
+		validators = append(validators, scoring.CreateValidator(vCfg.Kind, vCfg.Identifier, interpolateParams(params, vars)))
+	}
+
+	return validators, nil
+}
+
+func (r *TestRunner) explainValidators(tc *models.TestCase, vCtx *scoring.ValidationContext) ([]*scoring.ValidationTrace, error) {
+	validators, err := r.resolveValidators(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*scoring.ValidationTrace
+	for _, validator := range validators {
+		traces = append(traces, scoring.ExplainValidator(validator, vCtx))
+	}
+	return traces, nil
+}
+
+func (r *TestRunner) executeRun(ctx context.Context, tc *models.TestCase, runNum int) models.RunResult {
+	if r.adapter != nil {
+		return r.executeAdapterRun(ctx, tc, runNum)
+	}
+
+	startTime := time.Now()
+
+	req := r.buildExecutionRequest(tc)
+	req.Seed = deriveRunSeed(r.seed, tc.TestID, runNum)
+	if override, ok := r.seedOverrides[tc.TestID]; ok {
+		req.Seed = override
+	}
+
+	resp, err := r.engine.Execute(ctx, req)
+	if err != nil {
+		return models.RunResult{
+			RunNumber:  runNum,
+			Status:     "error",
+			DurationMs: time.Since(startTime).Milliseconds(),
+			ErrorMsg:   err.Error(),
+			Seed:       req.Seed,
+		}
+	}
+
+	vCtx := r.buildValidationContext(tc, resp)
+
+	validations, err := r.runValidators(tc, vCtx)
+	if err != nil {
+		return models.RunResult{
+			RunNumber:  runNum,
+			Status:     "error",
+			DurationMs: time.Since(startTime).Milliseconds(),
+			ErrorMsg:   err.Error(),
+			Seed:       req.Seed,
+		}
+	}
+
+	status := "passed"
+	if resp.ErrorMsg != "" {
+		status = "error"
+	} else {
+		for _, v := range validations {
+			if !v.Passed {
+				status = "failed"
+				break
+			}
+		}
+	}
+
+	return models.RunResult{
+		RunNumber:     runNum,
+		Status:        status,
+		DurationMs:    resp.DurationMs,
+		Validations:   validations,
+		SessionDigest: r.buildSessionDigest(resp),
+		Transcript:    r.buildTranscript(resp),
+		FinalOutput:   resp.FinalOutput,
+		ErrorMsg:      resp.ErrorMsg,
+		Seed:          resp.Seed,
+	}
+}
+
+// executeAdapterRun delegates a run to the configured non-LLM Adapter
+// instead of the engine+grading pipeline executeRun otherwise follows: the
+// adapter reports its own Validations/Status directly.
+func (r *TestRunner) executeAdapterRun(ctx context.Context, tc *models.TestCase, runNum int) models.RunResult {
+	run, err := r.adapter.Evaluate(ctx, tc)
+	if err != nil {
+		return models.RunResult{
+			RunNumber: runNum,
+			Status:    "error",
+			ErrorMsg:  err.Error(),
+		}
+	}
+
+	run.RunNumber = runNum
+	return run
+}
+
+func (r *TestRunner) buildExecutionRequest(tc *models.TestCase) *execution.ExecutionRequest {
+	vars := r.currentVars()
+	resources := r.loadResources(tc, vars)
+
+	spec := r.cfg.Spec()
+	timeout := spec.Config.TimeoutSec
+	if tc.TimeoutSec != nil {
+		timeout = *tc.TimeoutSec
+	}
+
+	return &execution.ExecutionRequest{
+		TestID:     tc.TestID,
+		Message:    interpolate(tc.Stimulus.Message, vars),
+		Context:    tc.Stimulus.Metadata,
+		Resources:  resources,
+		SkillName:  spec.SkillName,
+		TimeoutSec: timeout,
+	}
+}
+
+func (r *TestRunner) loadResources(tc *models.TestCase, vars map[string]any) []execution.ResourceFile {
+	var resources []execution.ResourceFile
+
+	fixtureDir := r.cfg.FixtureDir()
+	if tc.ContextRoot != "" {
+		fixtureDir = tc.ContextRoot
+	}
+
+	for _, ref := range tc.Stimulus.Resources {
+		if ref.Body != "" {
+			resources = append(resources, execution.ResourceFile{
+				Path:    ref.Location,
+				Content: interpolate(ref.Body, vars),
+			})
+		} else if ref.Location != "" && fixtureDir != "" {
+			if filepath.IsAbs(ref.Location) {
+				fmt.Fprintf(os.Stderr, "Warning: absolute resource path %q rejected\n", ref.Location)
+				continue
+			}
+
+			cleanPath := filepath.Clean(ref.Location)
+			if strings.Contains(cleanPath, "..") {
+				fmt.Fprintf(os.Stderr, "Warning: resource path %q contains '..' and is rejected\n", ref.Location)
+				continue
+			}
+
+			fullPath := filepath.Join(fixtureDir, cleanPath)
+
+			absFixtureDir, err := filepath.Abs(fixtureDir)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to get absolute path for fixture dir: %v\n", err)
+				continue
+			}
+
+			absFullPath, err := filepath.Abs(fullPath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to get absolute path for resource: %v\n", err)
+				continue
+			}
+
+			if !strings.HasPrefix(absFullPath, absFixtureDir+string(filepath.Separator)) {
+				fmt.Fprintf(os.Stderr, "Warning: resource path %q escapes fixture directory\n", ref.Location)
+				continue
+			}
+
+			content, err := os.ReadFile(absFullPath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to load resource file %s: %v\n", absFullPath, err)
+				continue
+			}
+			resources = append(resources, execution.ResourceFile{
+				Path:    ref.Location,
+				Content: string(content),
+			})
+		}
+	}
+
+	return resources
+}
+
+func (r *TestRunner) buildValidationContext(tc *models.TestCase, resp *execution.ExecutionResponse) *scoring.ValidationContext {
+	var transcript []models.TranscriptEntry
+	for _, evt := range resp.Events {
+		transcript = append(transcript, models.TranscriptEntry{
+			Type: evt.EventType,
+			Data: evt.Payload,
+		})
+	}
+
+	var toolCalls []models.ToolCall
+	for _, call := range resp.ToolCalls {
+		toolCalls = append(toolCalls, models.ToolCall{
+			Name:      call.Name,
+			Arguments: call.Arguments,
+			Result:    call.Result,
+			Success:   call.Success,
+		})
+	}
+
+	return &scoring.ValidationContext{
+		TestCase:   tc,
+		Transcript: transcript,
+		ToolCalls:  toolCalls,
+		Output:     resp.FinalOutput,
+		Outcome:    make(map[string]any),
+		DurationMs: resp.DurationMs,
+		Metadata:   make(map[string]any),
+	}
+}
+
+func (r *TestRunner) runValidators(tc *models.TestCase, vCtx *scoring.ValidationContext) (map[string]models.ValidationOut, error) {
+	validators, err := r.resolveValidators(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]models.ValidationOut)
+	for _, validator := range validators {
+		result := validator.Validate(vCtx)
+		results[result.Identifier] = *result
+	}
+	return results, nil
+}
+
+func (r *TestRunner) buildSessionDigest(resp *execution.ExecutionResponse) models.SessionDigest {
+	toolsUsed := make([]string, 0)
+	for _, call := range resp.ToolCalls {
+		toolsUsed = append(toolsUsed, call.Name)
+	}
+
+	return models.SessionDigest{
+		TotalTurns:    len(resp.Events),
+		ToolCallCount: len(resp.ToolCalls),
+		ToolsUsed:     toolsUsed,
+		Errors:        []string{},
+	}
+}
+
+func (r *TestRunner) buildTranscript(resp *execution.ExecutionResponse) []models.TranscriptEntry {
+	entries := make([]models.TranscriptEntry, 0, len(resp.Events))
+	for _, evt := range resp.Events {
+		entries = append(entries, models.TranscriptEntry{
+			Type: evt.EventType,
+			Data: evt.Payload,
+		})
+	}
+	return entries
+}
+
+func (r *TestRunner) computeTestStats(runs []models.RunResult) *models.TestStats {
+	if len(runs) == 0 {
+		return nil
+	}
+
+	passed := 0
+	totalScore := 0.0
+	minScore := 1.0
+	maxScore := 0.0
+	totalDuration := int64(0)
+
+	for _, run := range runs {
+		score := run.ComputeRunScore()
+		totalScore += score
+
+		if score < minScore {
+			minScore = score
+		}
+		if score > maxScore {
+			maxScore = score
+		}
+
+		if run.AllValidationsPassed() {
+			passed++
+		}
+
+		totalDuration += run.DurationMs
+	}
+
+	return &models.TestStats{
+		PassRate:      float64(passed) / float64(len(runs)),
+		AvgScore:      totalScore / float64(len(runs)),
+		MinScore:      minScore,
+		MaxScore:      maxScore,
+		AvgDurationMs: totalDuration / int64(len(runs)),
+	}
+}
+
+func (r *TestRunner) buildOutcome(testOutcomes []models.TestOutcome, startTime time.Time) *models.EvaluationOutcome {
+	spec := r.cfg.Spec()
+
+	succeeded := 0
+	failed := 0
+	errors := 0
+	skippedCount := 0
+
+	for _, to := range testOutcomes {
+		switch to.Status {
+		case "passed":
+			succeeded++
+		case "failed":
+			failed++
+		case "error":
+			errors++
+		case "skipped":
+			skippedCount++
+		}
+	}
+
+	totalTests := len(testOutcomes)
+	executedTests := totalTests - skippedCount
+	successRate := 0.0
+	if executedTests > 0 {
+		successRate = float64(succeeded) / float64(executedTests)
+	}
+
+	aggregateScore := r.computeAggregateScore(testOutcomes)
+
+	return &models.EvaluationOutcome{
+		RunID:       fmt.Sprintf("run-%d", time.Now().Unix()),
+		SkillTested: spec.SkillName,
+		BenchName:   spec.Name,
+		Timestamp:   startTime,
+		Setup: models.OutcomeSetup{
+			RunsPerTest: spec.Config.RunsPerTest,
+			ModelID:     spec.Config.ModelID,
+			EngineType:  spec.Config.EngineType,
+			TimeoutSec:  spec.Config.TimeoutSec,
+		},
+		Digest: models.OutcomeDigest{
+			TotalTests:     totalTests,
+			Succeeded:      succeeded,
+			Failed:         failed,
+			Errors:         errors,
+			Skipped:        skippedCount,
+			SuccessRate:    successRate,
+			AggregateScore: aggregateScore,
+			DurationMs:     time.Since(startTime).Milliseconds(),
+		},
+		Measures:     make(map[string]models.MeasureResult),
+		TestOutcomes: testOutcomes,
+		Metadata:     make(map[string]any),
+	}
+}
+
+func (r *TestRunner) computeAggregateScore(testOutcomes []models.TestOutcome) float64 {
+	if len(testOutcomes) == 0 {
+		return 0.0
+	}
+
+	totalScore := 0.0
+	for _, to := range testOutcomes {
+		if to.Stats != nil {
+			totalScore += to.Stats.AvgScore
+		}
+	}
+
+	return totalScore / float64(len(testOutcomes))
+}
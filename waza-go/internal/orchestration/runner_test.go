@@ -0,0 +1,150 @@
+package orchestration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/config"
+	"github.com/spboyer/waza/waza-go/internal/execution"
+	"github.com/spboyer/waza/waza-go/internal/filter"
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func writeTestSpec(t *testing.T, dir string, taskFiles ...string) {
+	t.Helper()
+	for i, name := range taskFiles {
+		content := `id: task-` + string(rune('0'+i)) + `
+name: Task ` + string(rune('0'+i)) + `
+inputs:
+  prompt: hello
+`
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write task file: %v", err)
+		}
+	}
+}
+
+func newTestRunner(t *testing.T, dir string, opts ...config.Option) *TestRunner {
+	t.Helper()
+	spec := &models.BenchmarkSpec{
+		SpecIdentity: models.SpecIdentity{Name: "test-bench"},
+		Tasks:        []string{"*.yaml"},
+		Config: models.Config{
+			RunsPerTest: 1,
+			TimeoutSec:  5,
+			EngineType:  "mock",
+		},
+	}
+	cfgOpts := append([]config.Option{config.WithSpecDir(dir)}, opts...)
+	cfg := config.NewBenchmarkConfig(spec, cfgOpts...)
+	return NewTestRunner(cfg, execution.NewMockEngine("mock-model"))
+}
+
+func TestRunBenchmark_RunsEveryTestCase(t *testing.T) {
+	dir := t.TempDir()
+	writeTestSpec(t, dir, "task0.yaml", "task1.yaml")
+
+	runner := newTestRunner(t, dir)
+	outcome, err := runner.RunBenchmark(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(outcome.TestOutcomes) != 2 {
+		t.Fatalf("expected 2 test outcomes, got %d", len(outcome.TestOutcomes))
+	}
+	if outcome.Digest.TotalTests != 2 {
+		t.Fatalf("expected 2 total tests, got %d", outcome.Digest.TotalTests)
+	}
+}
+
+func TestRunBenchmark_AppliesFocusFilter(t *testing.T) {
+	dir := t.TempDir()
+	writeTestSpec(t, dir, "task0.yaml", "task1.yaml")
+
+	runner := newTestRunner(t, dir, config.WithFilter(filter.Opts{Focus: "task-0"}))
+	outcome, err := runner.RunBenchmark(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ran, skipped int
+	for _, to := range outcome.TestOutcomes {
+		switch to.Status {
+		case "skipped":
+			skipped++
+		default:
+			ran++
+		}
+	}
+	if ran != 1 || skipped != 1 {
+		t.Fatalf("expected 1 run and 1 skipped outcome, got ran=%d skipped=%d", ran, skipped)
+	}
+}
+
+func TestApplyRunPlan_Sharding(t *testing.T) {
+	dir := t.TempDir()
+	runner := newTestRunner(t, dir, config.WithRunPlan(config.RunPlan{ShardIndex: 1, ShardTotal: 2, Seed: 1}))
+
+	testCases := []*models.TestCase{
+		{TestID: "a"}, {TestID: "b"}, {TestID: "c"}, {TestID: "d"},
+	}
+	shard, seed := runner.applyRunPlan(testCases)
+	if seed != 1 {
+		t.Fatalf("expected seed 1, got %d", seed)
+	}
+	if len(shard) != 2 {
+		t.Fatalf("expected shard of 2 test cases, got %d", len(shard))
+	}
+	if shard[0].TestID != "b" || shard[1].TestID != "d" {
+		t.Fatalf("expected shard [b, d], got %v", []string{shard[0].TestID, shard[1].TestID})
+	}
+}
+
+func TestResolveSeed_PrefersFlagOverSpec(t *testing.T) {
+	dir := t.TempDir()
+	runner := newTestRunner(t, dir, config.WithRunPlan(config.RunPlan{Seed: 42}))
+	runner.cfg.Spec().Config.Seed = 99
+
+	if got := runner.resolveSeed(); got != 42 {
+		t.Fatalf("expected flag seed 42 to win, got %d", got)
+	}
+}
+
+func TestRunBenchmark_UsesOverriddenSeed(t *testing.T) {
+	dir := t.TempDir()
+	writeTestSpec(t, dir, "task0.yaml")
+
+	runner := newTestRunner(t, dir)
+	runner.OverrideSeed("task-0", 12345)
+
+	outcome, err := runner.RunBenchmark(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(outcome.TestOutcomes) != 1 || len(outcome.TestOutcomes[0].Runs) != 1 {
+		t.Fatalf("expected exactly one test with one run, got %+v", outcome.TestOutcomes)
+	}
+	if got := outcome.TestOutcomes[0].Runs[0].Seed; got != 12345 {
+		t.Fatalf("expected the overridden seed 12345 to be used, got %d", got)
+	}
+}
+
+func TestDeriveRunSeed_DeterministicPerTestAndRun(t *testing.T) {
+	a := deriveRunSeed(1, "test-a", 1)
+	b := deriveRunSeed(1, "test-a", 1)
+	if a != b {
+		t.Fatalf("expected deriveRunSeed to be deterministic, got %d and %d", a, b)
+	}
+
+	c := deriveRunSeed(1, "test-a", 2)
+	if a == c {
+		t.Fatalf("expected different run numbers to derive different seeds")
+	}
+
+	d := deriveRunSeed(1, "test-b", 1)
+	if a == d {
+		t.Fatalf("expected different test IDs to derive different seeds")
+	}
+}
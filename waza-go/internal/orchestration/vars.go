@@ -0,0 +1,156 @@
+package orchestration
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// mergeVars layers overrides on top of base, without mutating either,
+// returning a fresh map. Used to seed TestRunner.vars from the spec's own
+// Variables plus --var flags, and again each time a test's Extracts are
+// merged back in.
+func mergeVars(base, overrides map[string]any) map[string]any {
+	merged := make(map[string]any, len(base)+len(overrides))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
+
+// interpolate renders text as a text/template against vars, enabling
+// {{.someVar}} substitution from spec-level variables, --var flags and
+// prior tests' Extracts. A template that fails to parse or execute (e.g. it
+// references a var that was never set) is returned unchanged rather than
+// failing the run — a typo in one test's {{}} shouldn't sink the benchmark.
+func interpolate(text string, vars map[string]any) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+
+	tmpl, err := template.New("var").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return text
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return text
+	}
+	return buf.String()
+}
+
+// interpolateParams interpolates every string-valued parameter in params
+// against vars, recursing into slices and nested maps (grader parameters
+// like RegexValidator's must_match or CodeValidator's assertions are
+// commonly lists of strings) and leaving other value types untouched.
+func interpolateParams(params map[string]any, vars map[string]any) map[string]any {
+	if len(params) == 0 {
+		return params
+	}
+
+	out := make(map[string]any, len(params))
+	for k, v := range params {
+		out[k] = interpolateValue(v, vars)
+	}
+	return out
+}
+
+// interpolateValue applies interpolate to a single parameter value,
+// recursing into []any and map[string]any so that nested strings are
+// substituted too.
+func interpolateValue(v any, vars map[string]any) any {
+	switch val := v.(type) {
+	case string:
+		return interpolate(val, vars)
+	case []string:
+		out := make([]string, len(val))
+		for i, item := range val {
+			out[i] = interpolate(item, vars)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = interpolateValue(item, vars)
+		}
+		return out
+	case map[string]any:
+		return interpolateParams(val, vars)
+	default:
+		return v
+	}
+}
+
+// extractValue applies a single Extraction to a completed run, returning
+// the captured string and whether anything matched.
+func extractValue(ext models.Extraction, run models.RunResult) (string, bool) {
+	source := run.FinalOutput
+	if ext.Source == "transcript" {
+		var lines []string
+		for _, entry := range run.Transcript {
+			// Engine events land in Data, so fall back to their "content".
+			content := entry.Content
+			if content == "" {
+				content, _ = entry.Data["content"].(string)
+			}
+			lines = append(lines, content)
+		}
+		source = strings.Join(lines, "\n")
+	}
+
+	switch {
+	case ext.Regex != "":
+		re, err := regexp.Compile(ext.Regex)
+		if err != nil {
+			return "", false
+		}
+		match := re.FindStringSubmatch(source)
+		if len(match) < 2 {
+			return "", false
+		}
+		return match[1], true
+
+	case ext.Path != "":
+		var data any
+		if err := json.Unmarshal([]byte(source), &data); err != nil {
+			return "", false
+		}
+		return lookupPath(data, ext.Path)
+
+	default:
+		return "", false
+	}
+}
+
+// lookupPath follows a dotted path (e.g. "auth.token") through parsed JSON.
+// This is a practical subset of JSONPath, not the full spec: no array
+// indices or wildcards.
+func lookupPath(data any, path string) (string, bool) {
+	cur := data
+	for _, key := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		cur, ok = m[key]
+		if !ok {
+			return "", false
+		}
+	}
+
+	if s, ok := cur.(string); ok {
+		return s, true
+	}
+	b, err := json.Marshal(cur)
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
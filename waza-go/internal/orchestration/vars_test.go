@@ -0,0 +1,94 @@
+package orchestration
+
+import (
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func TestMergeVars_OverridesWinOverBase(t *testing.T) {
+	base := map[string]any{"a": "1", "b": "2"}
+	overrides := map[string]any{"b": "3", "c": "4"}
+
+	merged := mergeVars(base, overrides)
+	if merged["a"] != "1" || merged["b"] != "3" || merged["c"] != "4" {
+		t.Fatalf("unexpected merge result: %+v", merged)
+	}
+	// Must not mutate the inputs.
+	if base["b"] != "2" {
+		t.Fatalf("mergeVars mutated its base map: %+v", base)
+	}
+}
+
+func TestInterpolate_SubstitutesVars(t *testing.T) {
+	got := interpolate("hello {{.name}}", map[string]any{"name": "world"})
+	if got != "hello world" {
+		t.Fatalf("expected %q, got %q", "hello world", got)
+	}
+}
+
+func TestInterpolate_LeavesInvalidTemplateUnchanged(t *testing.T) {
+	text := "hello {{.name"
+	got := interpolate(text, map[string]any{"name": "world"})
+	if got != text {
+		t.Fatalf("expected unchanged text %q, got %q", text, got)
+	}
+}
+
+func TestInterpolateParams_RecursesIntoLists(t *testing.T) {
+	params := map[string]any{
+		"must_match": []any{"token={{.token}}", "static"},
+	}
+	vars := map[string]any{"token": "abc123"}
+
+	out := interpolateParams(params, vars)
+	got, ok := out["must_match"].([]any)
+	if !ok || len(got) != 2 {
+		t.Fatalf("expected a 2-element slice, got %+v", out["must_match"])
+	}
+	if got[0] != "token=abc123" || got[1] != "static" {
+		t.Fatalf("expected interpolated list, got %+v", got)
+	}
+}
+
+func TestInterpolateParams_RecursesIntoNestedMaps(t *testing.T) {
+	params := map[string]any{
+		"nested": map[string]any{"greeting": "hello {{.name}}"},
+	}
+	vars := map[string]any{"name": "world"}
+
+	out := interpolateParams(params, vars)
+	nested, ok := out["nested"].(map[string]any)
+	if !ok || nested["greeting"] != "hello world" {
+		t.Fatalf("expected nested map to be interpolated, got %+v", out["nested"])
+	}
+}
+
+func TestExtractValue_Regex(t *testing.T) {
+	ext := models.Extraction{Var: "token", Regex: `token=(\w+)`}
+	run := models.RunResult{FinalOutput: "response token=abc123 done"}
+
+	value, ok := extractValue(ext, run)
+	if !ok || value != "abc123" {
+		t.Fatalf("expected abc123, got %q (ok=%v)", value, ok)
+	}
+}
+
+func TestExtractValue_Path(t *testing.T) {
+	ext := models.Extraction{Var: "token", Path: "auth.token"}
+	run := models.RunResult{FinalOutput: `{"auth": {"token": "xyz"}}`}
+
+	value, ok := extractValue(ext, run)
+	if !ok || value != "xyz" {
+		t.Fatalf("expected xyz, got %q (ok=%v)", value, ok)
+	}
+}
+
+func TestExtractValue_NoMatchReturnsFalse(t *testing.T) {
+	ext := models.Extraction{Var: "token", Regex: `token=(\w+)`}
+	run := models.RunResult{FinalOutput: "no token here"}
+
+	if _, ok := extractValue(ext, run); ok {
+		t.Fatalf("expected no match")
+	}
+}
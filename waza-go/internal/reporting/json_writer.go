@@ -0,0 +1,18 @@
+package reporting
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// JSONWriter marshals the whole outcome as indented JSON, matching what
+// saveOutcome has always written.
+type JSONWriter struct{}
+
+func (w *JSONWriter) Write(outcome *models.EvaluationOutcome, out io.Writer) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(outcome)
+}
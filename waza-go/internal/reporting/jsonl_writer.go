@@ -0,0 +1,67 @@
+package reporting
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// jsonlRun is one line of JSONLWriter's output: a single run flattened
+// alongside its test's identity, so a consumer doesn't need to reconstruct
+// the test/run nesting to process results as a stream.
+type jsonlRun struct {
+	TestID      string            `json:"test_id"`
+	DisplayName string            `json:"display_name"`
+	RunNumber   int               `json:"run_number"`
+	Status      string            `json:"status"`
+	DurationMs  int64             `json:"duration_ms"`
+	Score       float64           `json:"score"`
+	Validations []jsonlValidation `json:"validations,omitempty"`
+	ErrorMsg    string            `json:"error_msg,omitempty"`
+}
+
+type jsonlValidation struct {
+	Identifier string  `json:"identifier"`
+	Kind       string  `json:"kind"`
+	Score      float64 `json:"score"`
+	Passed     bool    `json:"passed"`
+	Feedback   string  `json:"feedback,omitempty"`
+}
+
+// JSONLWriter writes one JSON object per test run, in the order the tests
+// appear in the outcome, so a log shipper can tail the file while a
+// benchmark is still running or process it line-by-line afterward.
+type JSONLWriter struct{}
+
+func (w *JSONLWriter) Write(outcome *models.EvaluationOutcome, out io.Writer) error {
+	enc := json.NewEncoder(out)
+
+	for _, to := range outcome.TestOutcomes {
+		for _, run := range to.Runs {
+			record := jsonlRun{
+				TestID:      to.TestID,
+				DisplayName: to.DisplayName,
+				RunNumber:   run.RunNumber,
+				Status:      run.Status,
+				DurationMs:  run.DurationMs,
+				Score:       run.ComputeRunScore(),
+				ErrorMsg:    run.ErrorMsg,
+			}
+			for _, v := range run.Validations {
+				record.Validations = append(record.Validations, jsonlValidation{
+					Identifier: v.Identifier,
+					Kind:       v.Kind,
+					Score:      v.Score,
+					Passed:     v.Passed,
+					Feedback:   v.Feedback,
+				})
+			}
+			if err := enc.Encode(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package reporting
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// junitTestSuites is the root element JUnit-consuming tools (GitHub Actions
+// test reporters, Jenkins) expect.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	TimeSec  float64         `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	TimeSec   float64       `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitError   `xml:"error,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+type junitError struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// JUnitWriter renders outcome as JUnit XML: one testcase per test run, so
+// tools like the GitHub Actions test-reporter or Jenkins' JUnit plugin can
+// plug a waza benchmark into their usual pass/fail summary.
+type JUnitWriter struct{}
+
+func (w *JUnitWriter) Write(outcome *models.EvaluationOutcome, out io.Writer) error {
+	suite := junitTestSuite{
+		Name:     outcome.BenchName,
+		Tests:    0,
+		Failures: outcome.Digest.Failed,
+		Errors:   outcome.Digest.Errors,
+		TimeSec:  float64(outcome.Digest.DurationMs) / 1000.0,
+	}
+
+	for _, to := range outcome.TestOutcomes {
+		for _, run := range to.Runs {
+			suite.Tests++
+
+			name := to.DisplayName
+			if len(to.Runs) > 1 {
+				name = fmt.Sprintf("%s (run %d)", to.DisplayName, run.RunNumber)
+			}
+
+			tc := junitTestCase{
+				ClassName: to.TestID,
+				Name:      name,
+				TimeSec:   float64(run.DurationMs) / 1000.0,
+			}
+
+			switch run.Status {
+			case "failed":
+				tc.Failure = &junitFailure{
+					Message: "one or more graders did not pass",
+					Body:    failureBody(run),
+				}
+			case "error":
+				tc.Error = &junitError{Message: run.ErrorMsg}
+			}
+
+			suite.Cases = append(suite.Cases, tc)
+		}
+	}
+
+	doc := junitTestSuites{Suites: []junitTestSuite{suite}}
+
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(out)
+	enc.Indent("", "  ")
+	return enc.Encode(doc)
+}
+
+func failureBody(run models.RunResult) string {
+	body := ""
+	for _, v := range run.Validations {
+		if !v.Passed {
+			body += fmt.Sprintf("%s: %s\n", v.Identifier, v.Feedback)
+		}
+	}
+	return body
+}
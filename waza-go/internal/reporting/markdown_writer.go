@@ -0,0 +1,54 @@
+package reporting
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// MarkdownWriter renders a short summary table suitable for appending to
+// $GITHUB_STEP_SUMMARY: an overall digest followed by one row per test.
+type MarkdownWriter struct{}
+
+func (w *MarkdownWriter) Write(outcome *models.EvaluationOutcome, out io.Writer) error {
+	digest := outcome.Digest
+
+	if _, err := fmt.Fprintf(out, "## %s\n\n", outcome.BenchName); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(out, "%d tests, %d succeeded, %d failed, %d errors, %d skipped (%.1f%% success rate)\n\n",
+		digest.TotalTests, digest.Succeeded, digest.Failed, digest.Errors, digest.Skipped, digest.SuccessRate*100); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(out, "| Test | Status | Avg Score |"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(out, "| --- | --- | --- |"); err != nil {
+		return err
+	}
+
+	for _, to := range outcome.TestOutcomes {
+		avgScore := 0.0
+		if to.Stats != nil {
+			avgScore = to.Stats.AvgScore
+		}
+		if _, err := fmt.Fprintf(out, "| %s | %s | %.2f |\n", to.DisplayName, statusEmoji(to.Status), avgScore); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func statusEmoji(status string) string {
+	switch status {
+	case "passed":
+		return "✅ passed"
+	case "failed":
+		return "❌ failed"
+	default:
+		return "⚠️ " + status
+	}
+}
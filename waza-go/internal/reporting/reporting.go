@@ -0,0 +1,45 @@
+// Package reporting turns a finished models.EvaluationOutcome into an
+// artifact some other tool can consume: a JUnit XML file for a CI test
+// summary, a TAP stream, JSON-lines for log shipping, or a Markdown summary
+// for $GITHUB_STEP_SUMMARY.
+package reporting
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// ReportWriter renders outcome in a specific format to w.
+type ReportWriter interface {
+	Write(outcome *models.EvaluationOutcome, w io.Writer) error
+}
+
+// Factory builds a new ReportWriter instance.
+type Factory func() ReportWriter
+
+var registry = map[string]Factory{}
+
+// Register adds (or replaces) the factory for a named report format, e.g.
+// "junit" or "tap". Called from each writer's init().
+func Register(name string, factory Factory) {
+	registry[name] = factory
+}
+
+// Create instantiates the ReportWriter registered for name.
+func Create(name string) (ReportWriter, error) {
+	factory, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown report format %q", name)
+	}
+	return factory(), nil
+}
+
+func init() {
+	Register("json", func() ReportWriter { return &JSONWriter{} })
+	Register("jsonl", func() ReportWriter { return &JSONLWriter{} })
+	Register("junit", func() ReportWriter { return &JUnitWriter{} })
+	Register("tap", func() ReportWriter { return &TAPWriter{} })
+	Register("markdown", func() ReportWriter { return &MarkdownWriter{} })
+}
@@ -0,0 +1,33 @@
+package reporting
+
+import "testing"
+
+func TestCreate_KnownFormats(t *testing.T) {
+	for _, name := range []string{"json", "jsonl", "junit", "tap", "markdown"} {
+		if _, err := Create(name); err != nil {
+			t.Errorf("Create(%q) returned error: %v", name, err)
+		}
+	}
+}
+
+func TestCreate_UnknownFormat(t *testing.T) {
+	if _, err := Create("nope"); err == nil {
+		t.Error("expected an error for an unregistered format")
+	}
+}
+
+func TestRegister_Overrides(t *testing.T) {
+	called := false
+	Register("json", func() ReportWriter {
+		called = true
+		return &JSONWriter{}
+	})
+	defer Register("json", func() ReportWriter { return &JSONWriter{} })
+
+	if _, err := Create("json"); err != nil {
+		t.Fatalf("Create(json) returned error: %v", err)
+	}
+	if !called {
+		t.Error("expected the overriding factory to be used")
+	}
+}
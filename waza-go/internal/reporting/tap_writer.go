@@ -0,0 +1,69 @@
+package reporting
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+// TAPWriter renders outcome as TAP version 13 (https://testanything.org/),
+// one "ok"/"not ok" line per test run, so any TAP consumer (prove, tap-ci
+// reporters) can summarize a waza benchmark the same way it would a unit
+// test suite.
+type TAPWriter struct{}
+
+func (w *TAPWriter) Write(outcome *models.EvaluationOutcome, out io.Writer) error {
+	total := 0
+	for _, to := range outcome.TestOutcomes {
+		total += len(to.Runs)
+	}
+
+	if _, err := fmt.Fprintln(out, "TAP version 13"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(out, "1..%d\n", total); err != nil {
+		return err
+	}
+
+	num := 0
+	for _, to := range outcome.TestOutcomes {
+		for _, run := range to.Runs {
+			num++
+
+			name := to.DisplayName
+			if len(to.Runs) > 1 {
+				name = fmt.Sprintf("%s (run %d)", to.DisplayName, run.RunNumber)
+			}
+
+			if run.Status == "passed" {
+				if _, err := fmt.Fprintf(out, "ok %d - %s\n", num, name); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if _, err := fmt.Fprintf(out, "not ok %d - %s\n", num, name); err != nil {
+				return err
+			}
+
+			reason := run.ErrorMsg
+			if reason == "" {
+				reason = failureBody(run)
+			}
+			if reason != "" {
+				if _, err := fmt.Fprintln(out, "  ---"); err != nil {
+					return err
+				}
+				if _, err := fmt.Fprintf(out, "  message: %q\n", reason); err != nil {
+					return err
+				}
+				if _, err := fmt.Fprintln(out, "  ..."); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
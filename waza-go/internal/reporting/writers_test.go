@@ -0,0 +1,115 @@
+package reporting
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func sampleOutcome() *models.EvaluationOutcome {
+	return &models.EvaluationOutcome{
+		BenchName: "demo-bench",
+		Digest: models.OutcomeDigest{
+			TotalTests:  2,
+			Succeeded:   1,
+			Failed:      1,
+			SuccessRate: 0.5,
+			DurationMs:  1500,
+		},
+		TestOutcomes: []models.TestOutcome{
+			{
+				TestID:      "test-1",
+				DisplayName: "greets the user",
+				Status:      "passed",
+				Stats:       &models.TestStats{AvgScore: 1.0},
+				Runs: []models.RunResult{
+					{RunNumber: 1, Status: "passed", DurationMs: 500},
+				},
+			},
+			{
+				TestID:      "test-2",
+				DisplayName: "handles bad input",
+				Status:      "failed",
+				Stats:       &models.TestStats{AvgScore: 0.0},
+				Runs: []models.RunResult{
+					{
+						RunNumber:  1,
+						Status:     "failed",
+						DurationMs: 1000,
+						Validations: map[string]models.ValidationOut{
+							"keyword": {Identifier: "keyword", Passed: false, Feedback: "missing required phrase"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestJUnitWriter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&JUnitWriter{}).Write(sampleOutcome(), &buf); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, `<testsuites>`) {
+		t.Error("expected a <testsuites> root element")
+	}
+	if !strings.Contains(out, `name="demo-bench"`) {
+		t.Error("expected the suite name to be the benchmark name")
+	}
+	if !strings.Contains(out, `<failure`) {
+		t.Error("expected a <failure> element for the failed run")
+	}
+}
+
+func TestTAPWriter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&TAPWriter{}).Write(sampleOutcome(), &buf); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "1..2") {
+		t.Error("expected a TAP plan line for 2 runs")
+	}
+	if !strings.Contains(out, "ok 1 - greets the user") {
+		t.Error("expected the passing run to be reported as ok")
+	}
+	if !strings.Contains(out, "not ok 2 - handles bad input") {
+		t.Error("expected the failing run to be reported as not ok")
+	}
+}
+
+func TestJSONLWriter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&JSONLWriter{}).Write(sampleOutcome(), &buf); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 JSONL lines, got %d", len(lines))
+	}
+	if !strings.Contains(lines[0], `"test_id":"test-1"`) {
+		t.Errorf("expected the first line to describe test-1, got %q", lines[0])
+	}
+}
+
+func TestMarkdownWriter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&MarkdownWriter{}).Write(sampleOutcome(), &buf); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "## demo-bench") {
+		t.Error("expected a heading with the benchmark name")
+	}
+	if !strings.Contains(out, "greets the user") || !strings.Contains(out, "handles bad input") {
+		t.Error("expected a row for each test")
+	}
+}
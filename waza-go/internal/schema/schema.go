@@ -0,0 +1,210 @@
+// Package schema validates benchmark YAML against embedded JSON Schemas
+// before it's unmarshaled into models types, the way cnab-go validates a
+// bundle's parameters against its embedded parameter definitions before
+// trusting them. Unlike a plain jsonschema.Validate call, Validate walks the
+// yaml.v3 node tree alongside the schema so every problem it finds - not
+// just the first - is reported with the line and column it came from.
+// Validate checks required fields, unknown fields (additionalProperties:
+// false) and enum values; the schemas' "type" and "minimum" constraints are
+// not checked.
+package schema
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed schemas/*.json
+var embedded embed.FS
+
+// Issue is a single problem found while validating a document against a
+// schema.
+type Issue struct {
+	Path    string
+	Message string
+	Line    int
+	Column  int
+}
+
+// SpecValidationError collects every Issue found during a single Validate
+// call, so a caller can print all of them instead of fixing one problem at a
+// time.
+type SpecValidationError struct {
+	Issues []Issue
+}
+
+func (e *SpecValidationError) Error() string {
+	lines := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		path := issue.Path
+		if path == "" {
+			path = "(root)"
+		}
+		lines = append(lines, fmt.Sprintf("%d:%d: %s: %s", issue.Line, issue.Column, path, issue.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Validate parses data as YAML and checks it against the named embedded
+// schema ("benchmark_spec" or "test_case"), returning a *SpecValidationError
+// listing every problem found, or nil if data is valid.
+func Validate(name string, data []byte) error {
+	sch, err := load(name)
+	if err != nil {
+		return err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if len(root.Content) == 0 {
+		return nil
+	}
+
+	var issues []Issue
+	walk(sch, root.Content[0], "", &issues)
+
+	if len(issues) == 0 {
+		return nil
+	}
+	return &SpecValidationError{Issues: issues}
+}
+
+// load reads and parses the named schema file, composing in the nested
+// GraderConfig/ValidatorInline item schemas for the top-level specs that
+// embed arrays of them.
+func load(name string) (*jsonschema.Schema, error) {
+	data, err := embedded.ReadFile("schemas/" + name + ".schema.json")
+	if err != nil {
+		return nil, fmt.Errorf("unknown schema %q", name)
+	}
+
+	var sch jsonschema.Schema
+	if err := json.Unmarshal(data, &sch); err != nil {
+		return nil, fmt.Errorf("failed to parse embedded schema %q: %w", name, err)
+	}
+
+	switch name {
+	case "benchmark_spec":
+		graderConfig, err := load("grader_config")
+		if err != nil {
+			return nil, err
+		}
+		if prop, ok := sch.Properties["graders"]; ok {
+			prop.Items = graderConfig
+		}
+	case "test_case":
+		validatorInline, err := load("validator_inline")
+		if err != nil {
+			return nil, err
+		}
+		if prop, ok := sch.Properties["graders"]; ok {
+			prop.Items = validatorInline
+		}
+	}
+
+	return &sch, nil
+}
+
+// disallowsAdditional reports whether ap is the schema jsonschema-go
+// produces for "additionalProperties: false" (a schema that matches
+// nothing).
+func disallowsAdditional(ap *jsonschema.Schema) bool {
+	return ap != nil && ap.Not != nil
+}
+
+func joinPath(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+	return parent + "." + child
+}
+
+// walk checks node against sch, appending every problem it finds to issues
+// and recursing into nested objects and array items.
+func walk(sch *jsonschema.Schema, node *yaml.Node, path string, issues *[]Issue) {
+	if sch == nil || node == nil {
+		return
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		walkMapping(sch, node, path, issues)
+	case yaml.SequenceNode:
+		if sch.Items != nil {
+			for _, item := range node.Content {
+				walk(sch.Items, item, path+"[]", issues)
+			}
+		}
+	default:
+		if len(sch.Enum) > 0 {
+			checkEnum(sch, node, path, issues)
+		}
+	}
+}
+
+func walkMapping(sch *jsonschema.Schema, node *yaml.Node, path string, issues *[]Issue) {
+	present := make(map[string]bool, len(node.Content)/2)
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode := node.Content[i]
+		valNode := node.Content[i+1]
+		present[keyNode.Value] = true
+
+		propSchema, known := sch.Properties[keyNode.Value]
+		if !known {
+			if disallowsAdditional(sch.AdditionalProperties) {
+				*issues = append(*issues, Issue{
+					Path:    joinPath(path, keyNode.Value),
+					Message: fmt.Sprintf("unknown field %q", keyNode.Value),
+					Line:    keyNode.Line,
+					Column:  keyNode.Column,
+				})
+			}
+			continue
+		}
+
+		if len(propSchema.Enum) > 0 {
+			checkEnum(propSchema, valNode, joinPath(path, keyNode.Value), issues)
+		}
+
+		walk(propSchema, valNode, joinPath(path, keyNode.Value), issues)
+	}
+
+	for _, required := range sch.Required {
+		if !present[required] {
+			*issues = append(*issues, Issue{
+				Path:    joinPath(path, required),
+				Message: fmt.Sprintf("missing required field %q", required),
+				Line:    node.Line,
+				Column:  node.Column,
+			})
+		}
+	}
+}
+
+func checkEnum(sch *jsonschema.Schema, node *yaml.Node, path string, issues *[]Issue) {
+	var value any
+	if err := node.Decode(&value); err != nil {
+		return
+	}
+
+	for _, allowed := range sch.Enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return
+		}
+	}
+
+	*issues = append(*issues, Issue{
+		Path:    path,
+		Message: fmt.Sprintf("%v is not one of %v", value, sch.Enum),
+		Line:    node.Line,
+		Column:  node.Column,
+	})
+}
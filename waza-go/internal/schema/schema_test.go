@@ -0,0 +1,109 @@
+package schema
+
+import "testing"
+
+func TestValidate_BenchmarkSpec_Valid(t *testing.T) {
+	data := []byte(`
+name: test-benchmark
+skill: test-skill
+tasks:
+  - tests/*.yaml
+config:
+  trials_per_task: 2
+  timeout_seconds: 120
+  executor: mock
+  model: test-model
+`)
+	if err := Validate("benchmark_spec", data); err != nil {
+		t.Fatalf("expected valid spec, got: %v", err)
+	}
+}
+
+func TestValidate_BenchmarkSpec_MissingRequired(t *testing.T) {
+	data := []byte(`
+name: test-benchmark
+config:
+  trials_per_task: 2
+  timeout_seconds: 120
+  executor: mock
+  model: test-model
+`)
+	err := Validate("benchmark_spec", data)
+	if err == nil {
+		t.Fatal("expected a validation error for a spec missing 'skill' and 'tasks'")
+	}
+
+	specErr, ok := err.(*SpecValidationError)
+	if !ok {
+		t.Fatalf("expected a *SpecValidationError, got %T", err)
+	}
+	if len(specErr.Issues) < 2 {
+		t.Errorf("expected at least 2 issues (skill, tasks), got %d: %v", len(specErr.Issues), specErr.Issues)
+	}
+}
+
+func TestValidate_BenchmarkSpec_UnknownFieldAndBadEnum(t *testing.T) {
+	data := []byte(`
+name: test-benchmark
+skill: test-skill
+tasks:
+  - tests/*.yaml
+bogus_field: true
+config:
+  trials_per_task: 2
+  timeout_seconds: 120
+  executor: not-a-real-engine
+  model: test-model
+`)
+	err := Validate("benchmark_spec", data)
+	specErr, ok := err.(*SpecValidationError)
+	if !ok {
+		t.Fatalf("expected a *SpecValidationError, got %T (%v)", err, err)
+	}
+
+	var sawUnknown, sawEnum bool
+	for _, issue := range specErr.Issues {
+		if issue.Path == "bogus_field" {
+			sawUnknown = true
+		}
+		if issue.Path == "config.executor" {
+			sawEnum = true
+		}
+	}
+	if !sawUnknown {
+		t.Errorf("expected an issue for the unknown field, got: %v", specErr.Issues)
+	}
+	if !sawEnum {
+		t.Errorf("expected an issue for the bad executor enum, got: %v", specErr.Issues)
+	}
+}
+
+func TestValidate_TestCase_Valid(t *testing.T) {
+	data := []byte(`
+id: test-001
+name: Test Case
+inputs:
+  prompt: Do the thing
+`)
+	if err := Validate("test_case", data); err != nil {
+		t.Fatalf("expected valid test case, got: %v", err)
+	}
+}
+
+func TestValidate_TestCase_MissingPrompt(t *testing.T) {
+	data := []byte(`
+id: test-001
+name: Test Case
+inputs:
+  context:
+    key: value
+`)
+	err := Validate("test_case", data)
+	specErr, ok := err.(*SpecValidationError)
+	if !ok {
+		t.Fatalf("expected a *SpecValidationError, got %T", err)
+	}
+	if len(specErr.Issues) != 1 || specErr.Issues[0].Path != "inputs.prompt" {
+		t.Errorf("expected a single missing 'inputs.prompt' issue, got: %v", specErr.Issues)
+	}
+}
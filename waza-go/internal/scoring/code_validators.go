@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 	"github.com/spboyer/waza/waza-go/internal/models"
 )
 
@@ -13,19 +16,78 @@ func init() {
 	RegisterValidator("regex", NewRegexValidator)
 }
 
-// CodeValidator validates using assertion expressions
+// codeEnv is the shape of the environment exposed to assertion expressions:
+// output, outcome, transcript, events, tool_calls and duration_ms, plus
+// expr's own builtins (len, lower, upper, trim, ... and the
+// contains/matches infix operators).
+type codeEnv struct {
+	Output     string                   `expr:"output"`
+	Outcome    map[string]any           `expr:"outcome"`
+	Transcript []models.TranscriptEntry `expr:"transcript"`
+	Events     []models.TranscriptEntry `expr:"events"`
+	ToolCalls  []exprToolCall           `expr:"tool_calls"`
+	DurationMs int64                    `expr:"duration_ms"`
+}
+
+// exprToolCall is the shape a models.ToolCall takes inside an assertion
+// expression, e.g. `tool_calls[0].name == "read_file"`.
+type exprToolCall struct {
+	Name      string         `expr:"name"`
+	Arguments map[string]any `expr:"arguments"`
+	Success   bool           `expr:"success"`
+}
+
+func buildCodeEnv(ctx *ValidationContext) codeEnv {
+	toolCalls := make([]exprToolCall, len(ctx.ToolCalls))
+	for i, call := range ctx.ToolCalls {
+		toolCalls[i] = exprToolCall{Name: call.Name, Arguments: call.Arguments, Success: call.Success}
+	}
+
+	return codeEnv{
+		Output:     ctx.Output,
+		Outcome:    ctx.Outcome,
+		Transcript: ctx.Transcript,
+		Events:     ctx.Transcript,
+		ToolCalls:  toolCalls,
+		DurationMs: ctx.DurationMs,
+	}
+}
+
+// compiledAssertion pairs an assertion's source with its compiled program, or
+// the error hit compiling it. Compiling at NewCodeValidator time rather than
+// per-Validate-call compiles each assertion once; a compile error is kept
+// and reported by Validate and Explain as that assertion's failure.
+type compiledAssertion struct {
+	source  string
+	program *vm.Program
+	err     error
+}
+
+// CodeValidator validates using boolean expr assertions evaluated against a
+// test's output, outcome, transcript and duration.
 type CodeValidator struct {
 	identifier string
-	assertions []string
+	assertions []compiledAssertion
 }
 
 func NewCodeValidator(identifier string, params map[string]any) Validator {
-	assertions := []string{}
+	var assertions []compiledAssertion
+	// Inline test-case graders pass their assertions as a []string.
+	if a, ok := params["assertions"].([]string); ok {
+		items := make([]any, len(a))
+		for i, s := range a {
+			items[i] = s
+		}
+		params = map[string]any{"assertions": items}
+	}
 	if a, ok := params["assertions"].([]any); ok {
 		for _, item := range a {
-			if s, ok := item.(string); ok {
-				assertions = append(assertions, s)
+			s, ok := item.(string)
+			if !ok {
+				continue
 			}
+			program, err := expr.Compile(s, expr.Env(codeEnv{}), expr.AsBool())
+			assertions = append(assertions, compiledAssertion{source: s, program: program, err: err})
 		}
 	}
 
@@ -50,15 +112,21 @@ func (v *CodeValidator) Validate(ctx *ValidationContext) *models.ValidationOut {
 			}
 		}
 
+		env := buildCodeEnv(ctx)
+
 		passed := 0
 		var failures []string
 
-		// Simple assertion evaluation
-		for _, assertion := range v.assertions {
-			if evaluateAssertion(assertion, ctx) {
+		for _, a := range v.assertions {
+			ok, err := evaluateAssertion(a, env)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("Failed: %s (%v)", a.source, err))
+				continue
+			}
+			if ok {
 				passed++
 			} else {
-				failures = append(failures, fmt.Sprintf("Failed: %s", assertion))
+				failures = append(failures, fmt.Sprintf("Failed: %s", a.source))
 			}
 		}
 
@@ -85,53 +153,109 @@ func (v *CodeValidator) Validate(ctx *ValidationContext) *models.ValidationOut {
 	})
 }
 
-// evaluateAssertion is a simple assertion evaluator
-func evaluateAssertion(assertion string, ctx *ValidationContext) bool {
-	// Simple pattern matching for common assertions
-	// In a real implementation, you'd use a proper expression evaluator
-
-	// len(output) > N
-	if matches := regexp.MustCompile(`len\(output\)\s*>\s*(\d+)`).FindStringSubmatch(assertion); len(matches) > 1 {
-		threshold := 0
-		if _, err := fmt.Sscanf(matches[1], "%d", &threshold); err != nil {
-			return false // Parsing failed
+// Explain evaluates each assertion individually and records the result as
+// its own TraceStep, so `waza explain` can show exactly which assertions
+// passed or failed rather than just the validator's overall verdict.
+func (v *CodeValidator) Explain(ctx *ValidationContext) *ValidationTrace {
+	start := time.Now()
+
+	env := buildCodeEnv(ctx)
+
+	var steps []TraceStep
+	allPassed := true
+	for _, a := range v.assertions {
+		ok, err := evaluateAssertion(a, env)
+		if err != nil {
+			allPassed = false
+			steps = append(steps, TraceStep{
+				Description: a.source,
+				Passed:      false,
+				Details:     map[string]any{"error": err.Error()},
+			})
+			continue
+		}
+		if !ok {
+			allPassed = false
 		}
-		return len(ctx.Output) > threshold
+		steps = append(steps, TraceStep{
+			Description: a.source,
+			Passed:      ok,
+		})
+	}
+
+	if len(steps) == 0 {
+		steps = append(steps, TraceStep{Description: "No assertions configured", Passed: true})
+	}
+
+	return &ValidationTrace{
+		Identifier: v.identifier,
+		Kind:       "code",
+		Passed:     allPassed,
+		DurationMs: time.Since(start).Milliseconds(),
+		Steps:      steps,
 	}
+}
 
-	// "text" in output.lower()
-	if matches := regexp.MustCompile(`['"](.+?)['"]\s+in\s+output\.lower\(\)`).FindStringSubmatch(assertion); len(matches) > 1 {
-		text := matches[1]
-		return strings.Contains(strings.ToLower(ctx.Output), strings.ToLower(text))
+// evaluateAssertion runs a pre-compiled assertion against env. A compile
+// error caught in NewCodeValidator is returned here too, so it shows up as a
+// per-assertion failure rather than silently dropping that assertion.
+func evaluateAssertion(a compiledAssertion, env codeEnv) (bool, error) {
+	if a.err != nil {
+		return false, fmt.Errorf("invalid assertion: %w", a.err)
 	}
 
-	// 'text' in output
-	if matches := regexp.MustCompile(`['"](.+?)['"]\s+in\s+output`).FindStringSubmatch(assertion); len(matches) > 1 {
-		text := matches[1]
-		return strings.Contains(ctx.Output, text)
+	out, err := expr.Run(a.program, env)
+	if err != nil {
+		return false, err
 	}
 
-	// Unknown pattern - return false to avoid false positives
-	// User should be notified that their assertion syntax is not recognized
-	return false
+	result, ok := out.(bool)
+	if !ok {
+		return false, fmt.Errorf("assertion did not evaluate to a boolean, got %T", out)
+	}
+	return result, nil
 }
 
-// RegexValidator validates using regex patterns
+// RegexValidator validates using regex patterns against the output, plus
+// optional assertions on which tools the session called.
 type RegexValidator struct {
-	identifier   string
-	mustMatch    []string
-	mustNotMatch []string
+	identifier      string
+	mustMatch       []string
+	mustNotMatch    []string
+	mustCallTool    []string
+	mustNotCallTool []string
+	maxToolCalls    int
+	hasMaxToolCalls bool
 }
 
 func NewRegexValidator(identifier string, params map[string]any) Validator {
 	mustMatch := extractStringSlice(params, "must_match")
 	mustNotMatch := extractStringSlice(params, "must_not_match")
+	mustCallTool := extractStringSlice(params, "must_call_tool")
+	mustNotCallTool := extractStringSlice(params, "must_not_call_tool")
+	maxToolCalls, hasMaxToolCalls := extractInt(params, "max_tool_calls")
 
 	return &RegexValidator{
-		identifier:   identifier,
-		mustMatch:    mustMatch,
-		mustNotMatch: mustNotMatch,
+		identifier:      identifier,
+		mustMatch:       mustMatch,
+		mustNotMatch:    mustNotMatch,
+		mustCallTool:    mustCallTool,
+		mustNotCallTool: mustNotCallTool,
+		maxToolCalls:    maxToolCalls,
+		hasMaxToolCalls: hasMaxToolCalls,
+	}
+}
+
+func extractInt(params map[string]any, key string) (int, bool) {
+	switch v := params[key].(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
 	}
+	return 0, false
 }
 
 func (v *RegexValidator) Identifier() string { return v.identifier }
@@ -165,7 +289,10 @@ func (v *RegexValidator) Validate(ctx *ValidationContext) *models.ValidationOut
 			}
 		}
 
-		totalChecks := len(v.mustMatch) + len(v.mustNotMatch)
+		toolFailures := v.checkToolCalls(ctx)
+		failures = append(failures, toolFailures...)
+
+		totalChecks := len(v.mustMatch) + len(v.mustNotMatch) + v.toolCheckCount()
 		passedChecks := totalChecks - len(failures)
 
 		score := 1.0
@@ -185,14 +312,141 @@ func (v *RegexValidator) Validate(ctx *ValidationContext) *models.ValidationOut
 			Passed:     len(failures) == 0,
 			Feedback:   feedback,
 			Details: map[string]any{
-				"must_match":     v.mustMatch,
-				"must_not_match": v.mustNotMatch,
-				"failures":       failures,
+				"must_match":         v.mustMatch,
+				"must_not_match":     v.mustNotMatch,
+				"must_call_tool":     v.mustCallTool,
+				"must_not_call_tool": v.mustNotCallTool,
+				"failures":           failures,
 			},
 		}
 	})
 }
 
+// checkToolCalls reports one failure message per must_call_tool/
+// must_not_call_tool/max_tool_calls condition the run violated.
+func (v *RegexValidator) checkToolCalls(ctx *ValidationContext) []string {
+	var failures []string
+	called := make(map[string]bool, len(ctx.ToolCalls))
+	for _, name := range ctx.ToolCallNames() {
+		called[name] = true
+	}
+
+	for _, name := range v.mustCallTool {
+		if !called[name] {
+			failures = append(failures, fmt.Sprintf("Expected tool call not made: %s", name))
+		}
+	}
+	for _, name := range v.mustNotCallTool {
+		if called[name] {
+			failures = append(failures, fmt.Sprintf("Forbidden tool call made: %s", name))
+		}
+	}
+	if v.hasMaxToolCalls && len(ctx.ToolCalls) > v.maxToolCalls {
+		failures = append(failures, fmt.Sprintf("Too many tool calls: %d (max %d)", len(ctx.ToolCalls), v.maxToolCalls))
+	}
+
+	return failures
+}
+
+// toolCheckCount is the number of tool-call conditions checkToolCalls
+// evaluates, used alongside its failures to compute a pass-rate score.
+func (v *RegexValidator) toolCheckCount() int {
+	count := len(v.mustCallTool) + len(v.mustNotCallTool)
+	if v.hasMaxToolCalls {
+		count++
+	}
+	return count
+}
+
+// Explain checks each must_match/must_not_match pattern and each
+// must_call_tool/must_not_call_tool/max_tool_calls condition individually,
+// recording the result as its own TraceStep.
+func (v *RegexValidator) Explain(ctx *ValidationContext) *ValidationTrace {
+	start := time.Now()
+
+	var steps []TraceStep
+	allPassed := true
+
+	for _, pattern := range v.mustMatch {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			allPassed = false
+			steps = append(steps, TraceStep{
+				Description: fmt.Sprintf("must_match: %s", pattern),
+				Passed:      false,
+				Details:     map[string]any{"error": err.Error()},
+			})
+			continue
+		}
+		ok := re.MatchString(ctx.Output)
+		if !ok {
+			allPassed = false
+		}
+		steps = append(steps, TraceStep{Description: fmt.Sprintf("must_match: %s", pattern), Passed: ok})
+	}
+
+	for _, pattern := range v.mustNotMatch {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			allPassed = false
+			steps = append(steps, TraceStep{
+				Description: fmt.Sprintf("must_not_match: %s", pattern),
+				Passed:      false,
+				Details:     map[string]any{"error": err.Error()},
+			})
+			continue
+		}
+		ok := !re.MatchString(ctx.Output)
+		if !ok {
+			allPassed = false
+		}
+		steps = append(steps, TraceStep{Description: fmt.Sprintf("must_not_match: %s", pattern), Passed: ok})
+	}
+
+	called := make(map[string]bool, len(ctx.ToolCalls))
+	for _, name := range ctx.ToolCallNames() {
+		called[name] = true
+	}
+
+	for _, name := range v.mustCallTool {
+		ok := called[name]
+		if !ok {
+			allPassed = false
+		}
+		steps = append(steps, TraceStep{Description: fmt.Sprintf("must_call_tool: %s", name), Passed: ok})
+	}
+	for _, name := range v.mustNotCallTool {
+		ok := !called[name]
+		if !ok {
+			allPassed = false
+		}
+		steps = append(steps, TraceStep{Description: fmt.Sprintf("must_not_call_tool: %s", name), Passed: ok})
+	}
+	if v.hasMaxToolCalls {
+		ok := len(ctx.ToolCalls) <= v.maxToolCalls
+		if !ok {
+			allPassed = false
+		}
+		steps = append(steps, TraceStep{
+			Description: fmt.Sprintf("max_tool_calls: %d", v.maxToolCalls),
+			Passed:      ok,
+			Details:     map[string]any{"actual": len(ctx.ToolCalls)},
+		})
+	}
+
+	if len(steps) == 0 {
+		steps = append(steps, TraceStep{Description: "No patterns configured", Passed: true})
+	}
+
+	return &ValidationTrace{
+		Identifier: v.identifier,
+		Kind:       "regex",
+		Passed:     allPassed,
+		DurationMs: time.Since(start).Milliseconds(),
+		Steps:      steps,
+	}
+}
+
 func extractStringSlice(params map[string]any, key string) []string {
 	result := []string{}
 	if val, ok := params[key].([]any); ok {
@@ -0,0 +1,51 @@
+package scoring
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCodeValidator_PassingAssertion(t *testing.T) {
+	v := NewCodeValidator("check", map[string]any{
+		"assertions": []any{`output == "hi"`},
+	})
+
+	result := v.Validate(&ValidationContext{Output: "hi"})
+	if !result.Passed {
+		t.Fatalf("expected Passed, got %+v", result)
+	}
+	if result.Score != 1.0 {
+		t.Fatalf("expected score 1.0, got %v", result.Score)
+	}
+}
+
+func TestCodeValidator_FailingAssertion(t *testing.T) {
+	v := NewCodeValidator("check", map[string]any{
+		"assertions": []any{`output == "hi"`},
+	})
+
+	result := v.Validate(&ValidationContext{Output: "bye"})
+	if result.Passed {
+		t.Fatalf("expected not Passed, got %+v", result)
+	}
+	if result.Score != 0.0 {
+		t.Fatalf("expected score 0.0, got %v", result.Score)
+	}
+	if !strings.Contains(result.Feedback, `output == "hi"`) {
+		t.Fatalf("expected feedback to name the failing assertion, got %q", result.Feedback)
+	}
+}
+
+func TestCodeValidator_CompileError(t *testing.T) {
+	v := NewCodeValidator("check", map[string]any{
+		"assertions": []any{"output ==="},
+	})
+
+	result := v.Validate(&ValidationContext{Output: "hi"})
+	if result.Passed {
+		t.Fatalf("expected not Passed for an invalid assertion")
+	}
+	if !strings.Contains(result.Feedback, "invalid assertion") {
+		t.Fatalf("expected feedback to mention the compile error, got %q", result.Feedback)
+	}
+}
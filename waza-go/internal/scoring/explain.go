@@ -0,0 +1,55 @@
+package scoring
+
+import "fmt"
+
+// TraceStep is one unit of work a validator performed while grading a run,
+// e.g. one assertion evaluated or one regex pattern checked.
+type TraceStep struct {
+	Description string         `json:"description"`
+	Passed      bool           `json:"passed"`
+	Details     map[string]any `json:"details,omitempty"`
+}
+
+// ValidationTrace is a step-by-step record of how a validator reached its
+// ValidationOut, for `waza explain`. It mirrors the ValidationOut it was
+// built alongside: same Identifier/Kind/Passed, plus the Steps that led
+// there.
+type ValidationTrace struct {
+	Identifier string      `json:"identifier"`
+	Kind       string      `json:"kind"`
+	Passed     bool        `json:"passed"`
+	DurationMs int64       `json:"duration_ms"`
+	Steps      []TraceStep `json:"steps"`
+}
+
+// ExplainableValidator is implemented by validators that can break their
+// Validate call down into individual steps. Validators that don't
+// implement it still get a trace via ExplainValidator, just with a single
+// step summarizing their ValidationOut.
+type ExplainableValidator interface {
+	Explain(ctx *ValidationContext) *ValidationTrace
+}
+
+// ExplainValidator runs v against ctx and returns a step-by-step trace,
+// using v's own Explain method if it implements ExplainableValidator and
+// falling back to a single-step trace built from Validate otherwise.
+func ExplainValidator(v Validator, ctx *ValidationContext) *ValidationTrace {
+	if e, ok := v.(ExplainableValidator); ok {
+		return e.Explain(ctx)
+	}
+
+	result := v.Validate(ctx)
+	return &ValidationTrace{
+		Identifier: result.Identifier,
+		Kind:       result.Kind,
+		Passed:     result.Passed,
+		DurationMs: result.DurationMs,
+		Steps: []TraceStep{
+			{
+				Description: fmt.Sprintf("%s validator (no step-by-step trace available)", result.Kind),
+				Passed:      result.Passed,
+				Details:     result.Details,
+			},
+		},
+	}
+}
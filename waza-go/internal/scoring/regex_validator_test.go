@@ -0,0 +1,65 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/spboyer/waza/waza-go/internal/models"
+)
+
+func TestRegexValidator_MustCallTool(t *testing.T) {
+	v := NewRegexValidator("check", map[string]any{
+		"must_call_tool": []any{"read_file"},
+	})
+
+	ctx := &ValidationContext{
+		ToolCalls: []models.ToolCall{{Name: "read_file"}},
+	}
+	result := v.Validate(ctx)
+	if !result.Passed {
+		t.Fatalf("expected Passed when the required tool was called, got %+v", result)
+	}
+
+	ctx = &ValidationContext{ToolCalls: []models.ToolCall{{Name: "write_file"}}}
+	result = v.Validate(ctx)
+	if result.Passed {
+		t.Fatalf("expected not Passed when the required tool was never called")
+	}
+}
+
+func TestRegexValidator_MustNotCallTool(t *testing.T) {
+	v := NewRegexValidator("check", map[string]any{
+		"must_not_call_tool": []any{"delete_file"},
+	})
+
+	ctx := &ValidationContext{ToolCalls: []models.ToolCall{{Name: "delete_file"}}}
+	result := v.Validate(ctx)
+	if result.Passed {
+		t.Fatalf("expected not Passed when a forbidden tool was called")
+	}
+
+	ctx = &ValidationContext{ToolCalls: []models.ToolCall{{Name: "read_file"}}}
+	result = v.Validate(ctx)
+	if !result.Passed {
+		t.Fatalf("expected Passed when the forbidden tool was never called, got %+v", result)
+	}
+}
+
+func TestRegexValidator_MaxToolCalls(t *testing.T) {
+	v := NewRegexValidator("check", map[string]any{
+		"max_tool_calls": 1,
+	})
+
+	ctx := &ValidationContext{
+		ToolCalls: []models.ToolCall{{Name: "a"}, {Name: "b"}},
+	}
+	result := v.Validate(ctx)
+	if result.Passed {
+		t.Fatalf("expected not Passed when tool calls exceed max_tool_calls")
+	}
+
+	ctx = &ValidationContext{ToolCalls: []models.ToolCall{{Name: "a"}}}
+	result = v.Validate(ctx)
+	if !result.Passed {
+		t.Fatalf("expected Passed when tool calls are within max_tool_calls, got %+v", result)
+	}
+}
@@ -22,12 +22,56 @@ type Validator interface {
 type ValidationContext struct {
 	TestCase   *models.TestCase
 	Transcript []models.TranscriptEntry
+	ToolCalls  []models.ToolCall
 	Output     string
 	Outcome    map[string]any
 	DurationMs int64
 	Metadata   map[string]any
 }
 
+// Events returns the full session transcript, i.e. every event the engine
+// recorded during the run (assistant messages, tool calls, and anything
+// else it emitted). It's an alias for Transcript, named to match the
+// "events" terminology graders assert against.
+func (c *ValidationContext) Events() []models.TranscriptEntry {
+	return c.Transcript
+}
+
+// AssistantMessages returns the content of every assistant.message entry in
+// the transcript, in order.
+func (c *ValidationContext) AssistantMessages() []string {
+	return messagesByType(c.Transcript, "assistant.message")
+}
+
+// SystemMessages returns the content of every system.message entry in the
+// transcript, in order.
+func (c *ValidationContext) SystemMessages() []string {
+	return messagesByType(c.Transcript, "system.message")
+}
+
+func messagesByType(transcript []models.TranscriptEntry, eventType string) []string {
+	var messages []string
+	for _, entry := range transcript {
+		if entry.Type != eventType {
+			continue
+		}
+		if content, ok := entry.Data["content"].(string); ok {
+			messages = append(messages, content)
+		}
+	}
+	return messages
+}
+
+// ToolCallNames returns the Name of every tool call recorded on the
+// context, in the order they were made.
+func (c *ValidationContext) ToolCallNames() []string {
+	names := make([]string, len(c.ToolCalls))
+	for i, call := range c.ToolCalls {
+		names[i] = call.Name
+	}
+	return names
+}
+
 // ValidatorRegistry manages validator implementations
 type ValidatorRegistry struct {
 	factories map[string]ValidatorFactory
@@ -0,0 +1,115 @@
+// Package testmatch implements a Go-testing-style test selection language:
+// slash-separated, per-segment-anchored regex patterns for -run/-skip, plus
+// a simple tag selector, modeled on `go test -run`/`-skip` and FerretDB's
+// testmatch subpackage.
+package testmatch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Matcher decides whether a test should run based on compiled -run/-skip
+// patterns and a tag selector. Skip takes precedence over run.
+type Matcher struct {
+	run  []*regexp.Regexp
+	skip []*regexp.Regexp
+	tags map[string]bool
+}
+
+// NewMatcher compiles a Matcher from Go-testing-style run/skip patterns and
+// a comma-separated tag selector (e.g. "@slow,@integration"). Each pattern
+// is split on "/" the way go test splits -run "Test/Subtest": every segment
+// is matched, anchored, against the corresponding slash-separated segment
+// of a test ID. run, skip or tagSelector may be empty to leave that
+// dimension unconstrained.
+func NewMatcher(run, skip, tagSelector string) (*Matcher, error) {
+	runPatterns, err := compileSegments(run)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -run pattern %q: %w", run, err)
+	}
+	skipPatterns, err := compileSegments(skip)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -skip pattern %q: %w", skip, err)
+	}
+
+	var tags map[string]bool
+	if tagSelector != "" {
+		tags = make(map[string]bool)
+		for _, t := range strings.Split(tagSelector, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				tags[t] = true
+			}
+		}
+	}
+
+	return &Matcher{run: runPatterns, skip: skipPatterns, tags: tags}, nil
+}
+
+func compileSegments(pattern string) ([]*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(pattern, "/")
+	patterns := make([]*regexp.Regexp, len(parts))
+	for i, part := range parts {
+		re, err := regexp.Compile("^(?:" + part + ")$")
+		if err != nil {
+			return nil, err
+		}
+		patterns[i] = re
+	}
+	return patterns, nil
+}
+
+// matchSegments reports whether id's slash-separated segments satisfy
+// patterns: each pattern segment must anchor-match the corresponding id
+// segment. Fewer patterns than id segments only constrains the segments it
+// has (so "auth" matches "auth/login"); more patterns than id segments can
+// never match.
+func matchSegments(patterns []*regexp.Regexp, id string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	idParts := strings.Split(id, "/")
+	if len(patterns) > len(idParts) {
+		return false
+	}
+
+	for i, re := range patterns {
+		if !re.MatchString(idParts[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match reports whether testID, given its tags, should run. Skip takes
+// precedence over run: a test matching -skip never runs even if it would
+// also match -run. When run is false, skip distinguishes why: true means
+// testID matched the -skip pattern, false means it simply didn't match
+// -run or the tag selector.
+func (m *Matcher) Match(testID string, tags []string) (run bool, skip bool) {
+	if len(m.skip) > 0 && matchSegments(m.skip, testID) {
+		return false, true
+	}
+	if !matchSegments(m.run, testID) {
+		return false, false
+	}
+	if len(m.tags) > 0 && !hasAnyTag(m.tags, tags) {
+		return false, false
+	}
+	return true, false
+}
+
+func hasAnyTag(selected map[string]bool, tags []string) bool {
+	for _, t := range tags {
+		if selected[t] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package testmatch
+
+import "testing"
+
+func TestMatcher_NoOpts(t *testing.T) {
+	m, err := NewMatcher("", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run, skip := m.Match("auth/login", nil); !run || skip {
+		t.Fatalf("expected run=true skip=false, got run=%v skip=%v", run, skip)
+	}
+}
+
+func TestMatcher_Run(t *testing.T) {
+	m, err := NewMatcher("auth/.*login.*", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if run, _ := m.Match("auth/login_basic", nil); !run {
+		t.Fatalf("expected auth/login_basic to match")
+	}
+	if run, _ := m.Match("auth/logout", nil); run {
+		t.Fatalf("expected auth/logout not to match")
+	}
+	if run, _ := m.Match("billing/login_basic", nil); run {
+		t.Fatalf("expected billing/login_basic not to match a differing first segment")
+	}
+}
+
+func TestMatcher_RunPrefix(t *testing.T) {
+	m, err := NewMatcher("auth", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run, _ := m.Match("auth/login_basic", nil); !run {
+		t.Fatalf("expected a shorter run pattern to match a deeper test ID")
+	}
+}
+
+func TestMatcher_Skip(t *testing.T) {
+	m, err := NewMatcher("", "flaky/.*", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	run, skip := m.Match("flaky/retry_test", nil)
+	if run || !skip {
+		t.Fatalf("expected run=false skip=true, got run=%v skip=%v", run, skip)
+	}
+
+	run, skip = m.Match("stable/retry_test", nil)
+	if !run || skip {
+		t.Fatalf("expected run=true skip=false, got run=%v skip=%v", run, skip)
+	}
+}
+
+func TestMatcher_SkipTakesPrecedenceOverRun(t *testing.T) {
+	m, err := NewMatcher(".*", "flaky/.*", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run, skip := m.Match("flaky/retry_test", nil); run || !skip {
+		t.Fatalf("expected -skip to win even though -run matches everything, got run=%v skip=%v", run, skip)
+	}
+}
+
+func TestMatcher_Tags(t *testing.T) {
+	m, err := NewMatcher("", "", "@slow,@integration")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if run, _ := m.Match("a", []string{"@slow"}); !run {
+		t.Fatalf("expected a test tagged @slow to match")
+	}
+	if run, _ := m.Match("a", []string{"@fast"}); run {
+		t.Fatalf("expected a test without a selected tag not to match")
+	}
+	if run, _ := m.Match("a", nil); run {
+		t.Fatalf("expected an untagged test not to match a tag selector")
+	}
+}
+
+func TestMatcher_InvalidPattern(t *testing.T) {
+	if _, err := NewMatcher("(", "", ""); err == nil {
+		t.Fatal("expected an error for an invalid -run pattern")
+	}
+	if _, err := NewMatcher("", "(", ""); err == nil {
+		t.Fatal("expected an error for an invalid -skip pattern")
+	}
+}